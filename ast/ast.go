@@ -0,0 +1,417 @@
+// Package ast is a syntax tree for GoDB queries, so tools such as linters and
+// rewriters can inspect and transform queries instead of matching condition
+// strings.
+//
+// Parse reads a query as sent to the server, and Format renders a tree back
+// into that form. The godb package converts between trees and builders:
+//
+//	q, err := client.Query(ctx).Table("users").Where(godb.Eq("age", 30)).Build()
+//	ast.Walk(q.Where, func(n ast.Node) bool {
+//		if id, ok := n.(*ast.Ident); ok && id.Name == "age" {
+//			id.Name = "age_years"
+//		}
+//		return true
+//	})
+//	rows, err := client.QueryFromAST(ctx, q).Exec()
+package ast
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Node is a node of the syntax tree.
+type Node interface {
+	node()
+}
+
+// Expr is an expression node.
+type Expr interface {
+	Node
+	expr()
+}
+
+// Query is a SELECT query.
+type Query struct {
+	Table   string
+	Columns []string // nil selects every column
+	Where   Expr     // nil matches every row
+	GroupBy []string
+	Having  Expr
+	OrderBy []OrderTerm
+	Limit   int // 0 means no limit
+	Offset  int
+}
+
+// OrderTerm is one term of an ORDER BY clause.
+type OrderTerm struct {
+	Column string
+	Desc   bool
+}
+
+// Ident is a column reference, possibly qualified, or * inside a call.
+type Ident struct {
+	Name string
+}
+
+// LiteralKind classifies a Literal.
+type LiteralKind int
+
+const (
+	String LiteralKind = iota
+	Number
+	Bool
+	Null
+)
+
+// Literal is a constant. Value holds strings unquoted and numbers and booleans
+// as written.
+type Literal struct {
+	Kind  LiteralKind
+	Value string
+}
+
+// Binary is a comparison such as a = 1, a LIKE 'x%' or a NOT LIKE 'x%', or an
+// arithmetic operation such as a + 1.
+type Binary struct {
+	Op    string
+	Left  Expr
+	Right Expr
+}
+
+// And is a conjunction.
+type And struct {
+	Exprs []Expr
+}
+
+// Or is a disjunction.
+type Or struct {
+	Exprs []Expr
+}
+
+// Not negates an expression.
+type Not struct {
+	Expr Expr
+}
+
+// In tests membership in a value list.
+type In struct {
+	Expr   Expr
+	Values []Expr
+	Not    bool
+}
+
+// Between tests that an expression lies in an inclusive range.
+type Between struct {
+	Expr   Expr
+	Lo, Hi Expr
+	Not    bool
+}
+
+// IsNull tests an expression for NULL.
+type IsNull struct {
+	Expr Expr
+	Not  bool
+}
+
+// Call is a function call such as json_extract(doc, '$.a').
+type Call struct {
+	Name string
+	Args []Expr
+}
+
+func (*Query) node()   {}
+func (*Ident) node()   {}
+func (*Literal) node() {}
+func (*Binary) node()  {}
+func (*And) node()     {}
+func (*Or) node()      {}
+func (*Not) node()     {}
+func (*In) node()      {}
+func (*Between) node() {}
+func (*IsNull) node()  {}
+func (*Call) node()    {}
+
+func (*Ident) expr()   {}
+func (*Literal) expr() {}
+func (*Binary) expr()  {}
+func (*And) expr()     {}
+func (*Or) expr()      {}
+func (*Not) expr()     {}
+func (*In) expr()      {}
+func (*Between) expr() {}
+func (*IsNull) expr()  {}
+func (*Call) expr()    {}
+
+// Walk visits n and its children depth-first, skipping the children of a node
+// for which fn returns false. Nil nodes are not visited.
+func Walk(n Node, fn func(Node) bool) {
+	if isNil(n) || !fn(n) {
+		return
+	}
+	switch n := n.(type) {
+	case *Query:
+		Walk(n.Where, fn)
+		Walk(n.Having, fn)
+	case *Binary:
+		Walk(n.Left, fn)
+		Walk(n.Right, fn)
+	case *And:
+		walkAll(n.Exprs, fn)
+	case *Or:
+		walkAll(n.Exprs, fn)
+	case *Not:
+		Walk(n.Expr, fn)
+	case *In:
+		Walk(n.Expr, fn)
+		walkAll(n.Values, fn)
+	case *Between:
+		Walk(n.Expr, fn)
+		Walk(n.Lo, fn)
+		Walk(n.Hi, fn)
+	case *IsNull:
+		Walk(n.Expr, fn)
+	case *Call:
+		walkAll(n.Args, fn)
+	}
+}
+
+func walkAll(exprs []Expr, fn func(Node) bool) {
+	for _, e := range exprs {
+		Walk(e, fn)
+	}
+}
+
+// Rewrite replaces every expression in e bottom-up with the result of fn, and
+// returns the new root. Returning the argument unchanged keeps it.
+func Rewrite(e Expr, fn func(Expr) Expr) Expr {
+	if isNil(e) {
+		return e
+	}
+	switch n := e.(type) {
+	case *Binary:
+		n.Left = Rewrite(n.Left, fn)
+		n.Right = Rewrite(n.Right, fn)
+	case *And:
+		rewriteAll(n.Exprs, fn)
+	case *Or:
+		rewriteAll(n.Exprs, fn)
+	case *Not:
+		n.Expr = Rewrite(n.Expr, fn)
+	case *In:
+		n.Expr = Rewrite(n.Expr, fn)
+		rewriteAll(n.Values, fn)
+	case *Between:
+		n.Expr = Rewrite(n.Expr, fn)
+		n.Lo = Rewrite(n.Lo, fn)
+		n.Hi = Rewrite(n.Hi, fn)
+	case *IsNull:
+		n.Expr = Rewrite(n.Expr, fn)
+	case *Call:
+		rewriteAll(n.Args, fn)
+	}
+	return fn(e)
+}
+
+func rewriteAll(exprs []Expr, fn func(Expr) Expr) {
+	for i, e := range exprs {
+		exprs[i] = Rewrite(e, fn)
+	}
+}
+
+// isNil reports whether n is nil or a typed nil pointer.
+func isNil(n Node) bool {
+	switch n := n.(type) {
+	case nil:
+		return true
+	case *Query:
+		return n == nil
+	case *Ident:
+		return n == nil
+	case *Literal:
+		return n == nil
+	case *Binary:
+		return n == nil
+	case *And:
+		return n == nil
+	case *Or:
+		return n == nil
+	case *Not:
+		return n == nil
+	case *In:
+		return n == nil
+	case *Between:
+		return n == nil
+	case *IsNull:
+		return n == nil
+	case *Call:
+		return n == nil
+	}
+	return false
+}
+
+// Format renders n as query text. A Query renders as the condition string a
+// QueryDataRequest carries: the WHERE expression followed by the GROUP BY,
+// HAVING, ORDER BY, LIMIT and OFFSET clauses. Its table and columns travel in
+// separate request fields and are not included.
+func Format(n Node) string {
+	if isNil(n) {
+		return ""
+	}
+	if q, ok := n.(*Query); ok {
+		return formatQuery(q)
+	}
+	return formatExpr(n.(Expr), precLowest)
+}
+
+func formatQuery(q *Query) string {
+	var clauses []string
+	if where := Format(q.Where); where != "" {
+		clauses = append(clauses, where)
+	}
+	if len(q.GroupBy) > 0 {
+		clauses = append(clauses, "GROUP BY "+strings.Join(q.GroupBy, ", "))
+	}
+	if having := Format(q.Having); having != "" {
+		clauses = append(clauses, "HAVING "+having)
+	}
+	if len(q.OrderBy) > 0 {
+		terms := make([]string, len(q.OrderBy))
+		for i, t := range q.OrderBy {
+			terms[i] = t.Column + " ASC"
+			if t.Desc {
+				terms[i] = t.Column + " DESC"
+			}
+		}
+		clauses = append(clauses, "ORDER BY "+strings.Join(terms, ", "))
+	}
+	if q.Limit > 0 {
+		clauses = append(clauses, "LIMIT "+strconv.Itoa(q.Limit))
+	}
+	if q.Offset > 0 {
+		clauses = append(clauses, "OFFSET "+strconv.Itoa(q.Offset))
+	}
+	return strings.Join(clauses, " ")
+}
+
+// Operator precedences, loosest first.
+const (
+	precLowest = iota
+	precOr
+	precAnd
+	precNot
+	precPredicate
+	precAdd
+	precMul
+	precPrimary
+)
+
+// precedence returns how tightly e binds.
+func precedence(e Expr) int {
+	switch e := e.(type) {
+	case *Or:
+		return precOr
+	case *And:
+		return precAnd
+	case *Not:
+		return precNot
+	case *Binary:
+		switch e.Op {
+		case "+", "-", "||":
+			return precAdd
+		case "*", "/", "%":
+			return precMul
+		}
+		return precPredicate
+	case *In, *Between, *IsNull:
+		return precPredicate
+	}
+	return precPrimary
+}
+
+// formatExpr renders e, parenthesized when it binds looser than min.
+func formatExpr(e Expr, min int) string {
+	s := formatBare(e)
+	if s != "" && precedence(e) < min {
+		return "(" + s + ")"
+	}
+	return s
+}
+
+func formatBare(e Expr) string {
+	switch e := e.(type) {
+	case *Ident:
+		return e.Name
+	case *Literal:
+		switch e.Kind {
+		case String:
+			return "'" + strings.ReplaceAll(e.Value, "'", "''") + "'"
+		case Null:
+			return "NULL"
+		}
+		return e.Value
+	case *Binary:
+		p := precedence(e)
+		// Operators are left-associative, so a right operand at the same
+		// level needs parentheses: a - (b - c).
+		return formatExpr(e.Left, p) + " " + e.Op + " " + formatExpr(e.Right, p+1)
+	case *And:
+		return joinExprs(e.Exprs, " AND ", precAnd+1)
+	case *Or:
+		// Conjunctions inside a disjunction are parenthesized for readability,
+		// as the builders render them.
+		return joinExprs(e.Exprs, " OR ", precAnd+1)
+	case *Not:
+		inner := formatExpr(e.Expr, precLowest)
+		if inner == "" {
+			return ""
+		}
+		return "NOT (" + inner + ")"
+	case *In:
+		values := make([]string, len(e.Values))
+		for i, v := range e.Values {
+			values[i] = formatExpr(v, precLowest)
+		}
+		op := " IN ("
+		if e.Not {
+			op = " NOT IN ("
+		}
+		return formatExpr(e.Expr, precAdd) + op + strings.Join(values, ", ") + ")"
+	case *Between:
+		op := " BETWEEN "
+		if e.Not {
+			op = " NOT BETWEEN "
+		}
+		return formatExpr(e.Expr, precAdd) + op + formatExpr(e.Lo, precAdd) + " AND " + formatExpr(e.Hi, precAdd)
+	case *IsNull:
+		if e.Not {
+			return formatExpr(e.Expr, precAdd) + " IS NOT NULL"
+		}
+		return formatExpr(e.Expr, precAdd) + " IS NULL"
+	case *Call:
+		args := make([]string, len(e.Args))
+		for i, a := range e.Args {
+			args[i] = formatExpr(a, precLowest)
+		}
+		return e.Name + "(" + strings.Join(args, ", ") + ")"
+	}
+	return ""
+}
+
+// joinExprs renders exprs separated by sep, dropping empty ones.
+func joinExprs(exprs []Expr, sep string, min int) string {
+	var kept []Expr
+	for _, e := range exprs {
+		if !isNil(e) && formatBare(e) != "" {
+			kept = append(kept, e)
+		}
+	}
+	if len(kept) == 1 {
+		return formatBare(kept[0])
+	}
+	parts := make([]string, len(kept))
+	for i, e := range kept {
+		parts[i] = formatExpr(e, min)
+	}
+	return strings.Join(parts, sep)
+}
@@ -0,0 +1,607 @@
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Parse parses a query. It accepts a SELECT statement,
+//
+//	SELECT name, age FROM users WHERE age > 30 ORDER BY name LIMIT 10
+//
+// or the condition string of a QueryDataRequest, which is the same statement
+// after WHERE:
+//
+//	age > 30 ORDER BY name ASC LIMIT 10
+func Parse(src string) (*Query, error) {
+	p, err := newParser(src)
+	if err != nil {
+		return nil, err
+	}
+	q := &Query{}
+	if p.keyword("SELECT") {
+		if err := p.parseSelect(q); err != nil {
+			return nil, err
+		}
+	} else if !p.atClause() {
+		if q.Where, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.parseClauses(q); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// ParseExpr parses a condition expression such as "a = 1 AND b IN (2, 3)".
+func ParseExpr(src string) (Expr, error) {
+	p, err := newParser(src)
+	if err != nil {
+		return nil, err
+	}
+	e, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, p.errorf(t, "unexpected %q", t.text)
+	}
+	return e, nil
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	text string // string tokens hold the unquoted value
+	pos  int
+}
+
+// lex splits src into tokens.
+func lex(src string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'':
+			var sb strings.Builder
+			j := i + 1
+			for {
+				if j >= len(src) {
+					return nil, fmt.Errorf("ast: unterminated string at offset %d", i)
+				}
+				if src[j] == '\'' {
+					if j+1 < len(src) && src[j+1] == '\'' {
+						sb.WriteByte('\'')
+						j += 2
+						continue
+					}
+					break
+				}
+				sb.WriteByte(src[j])
+				j++
+			}
+			toks = append(toks, token{tokString, sb.String(), i})
+			i = j + 1
+		case c >= '0' && c <= '9' || c == '.' && i+1 < len(src) && src[i+1] >= '0' && src[i+1] <= '9':
+			j := i
+			for j < len(src) && (src[j] >= '0' && src[j] <= '9' || src[j] == '.') {
+				j++
+			}
+			if j < len(src) && (src[j] == 'e' || src[j] == 'E') {
+				k := j + 1
+				if k < len(src) && (src[k] == '+' || src[k] == '-') {
+					k++
+				}
+				if k < len(src) && src[k] >= '0' && src[k] <= '9' {
+					for j = k; j < len(src) && src[j] >= '0' && src[j] <= '9'; j++ {
+					}
+				}
+			}
+			toks = append(toks, token{tokNumber, src[i:j], i})
+			i = j
+		case c == '_' || c == '"' || c == '`' || c >= 0x80 || unicode.IsLetter(rune(c)):
+			j := i
+			for j < len(src) {
+				if q := src[j]; q == '"' || q == '`' {
+					end := strings.IndexByte(src[j+1:], q)
+					if end < 0 {
+						return nil, fmt.Errorf("ast: unterminated identifier at offset %d", j)
+					}
+					j += end + 2
+					continue
+				}
+				r := rune(src[j])
+				if r == '_' || r == '.' || r >= 0x80 || unicode.IsLetter(r) || unicode.IsDigit(r) {
+					j++
+					continue
+				}
+				break
+			}
+			toks = append(toks, token{tokIdent, src[i:j], i})
+			i = j
+		default:
+			op := string(c)
+			if i+1 < len(src) {
+				switch two := src[i : i+2]; two {
+				case "<=", ">=", "<>", "!=", "||":
+					op = two
+				}
+			}
+			if !strings.Contains("=<>!+-*/%(),|", op[:1]) || op == "!" || op == "|" {
+				return nil, fmt.Errorf("ast: unexpected %q at offset %d", op, i)
+			}
+			toks = append(toks, token{tokOp, op, i})
+			i += len(op)
+		}
+	}
+	return append(toks, token{tokEOF, "", len(src)}), nil
+}
+
+type parser struct {
+	src  string
+	toks []token
+	pos  int
+}
+
+func newParser(src string) (*parser, error) {
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	return &parser{src: src, toks: toks}, nil
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) error {
+	return fmt.Errorf("ast: offset %d: %s", t.pos, fmt.Sprintf(format, args...))
+}
+
+// isKeyword reports whether the token at offset n from the current one is the
+// keyword kw.
+func (p *parser) isKeyword(n int, kw string) bool {
+	if p.pos+n >= len(p.toks) {
+		return false
+	}
+	t := p.toks[p.pos+n]
+	return t.kind == tokIdent && strings.EqualFold(t.text, kw)
+}
+
+// keyword consumes the keyword kw if it is next.
+func (p *parser) keyword(kw string) bool {
+	if p.isKeyword(0, kw) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expectKeyword(kw string) error {
+	if !p.keyword(kw) {
+		t := p.peek()
+		return p.errorf(t, "expected %s, found %q", kw, t.text)
+	}
+	return nil
+}
+
+// op consumes the operator o if it is next.
+func (p *parser) op(o string) bool {
+	if t := p.peek(); t.kind == tokOp && t.text == o {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expectOp(o string) error {
+	if !p.op(o) {
+		t := p.peek()
+		return p.errorf(t, "expected %q, found %q", o, t.text)
+	}
+	return nil
+}
+
+// atClause reports whether the next token starts a clause following WHERE, or
+// ends the input.
+func (p *parser) atClause() bool {
+	return p.peek().kind == tokEOF ||
+		p.isKeyword(0, "GROUP") || p.isKeyword(0, "HAVING") || p.isKeyword(0, "ORDER") ||
+		p.isKeyword(0, "LIMIT") || p.isKeyword(0, "OFFSET")
+}
+
+// parseSelect parses "cols FROM table [WHERE expr]" after SELECT.
+func (p *parser) parseSelect(q *Query) error {
+	start := p.peek().pos
+	depth := 0
+	for !(depth == 0 && p.isKeyword(0, "FROM")) {
+		t := p.next()
+		switch {
+		case t.kind == tokEOF:
+			return p.errorf(t, "expected FROM")
+		case t.kind == tokOp && t.text == "(":
+			depth++
+		case t.kind == tokOp && t.text == ")":
+			depth--
+		}
+	}
+	if cols := strings.TrimSpace(p.src[start:p.peek().pos]); cols != "*" {
+		q.Columns = splitColumns(cols)
+	}
+	p.pos++ // FROM
+	t := p.next()
+	if t.kind != tokIdent {
+		return p.errorf(t, "expected table name, found %q", t.text)
+	}
+	q.Table = t.text
+	if p.keyword("WHERE") {
+		var err error
+		if q.Where, err = p.parseExpr(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitColumns splits a select list on commas outside parentheses.
+func splitColumns(cols string) []string {
+	var out []string
+	depth, start := 0, 0
+	for i, r := range cols {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				out = append(out, strings.TrimSpace(cols[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(out, strings.TrimSpace(cols[start:]))
+}
+
+// parseClauses parses the GROUP BY, HAVING, ORDER BY, LIMIT and OFFSET clauses.
+func (p *parser) parseClauses(q *Query) error {
+	var err error
+	if p.keyword("GROUP") {
+		if err := p.expectKeyword("BY"); err != nil {
+			return err
+		}
+		if q.GroupBy, err = p.parseNames(); err != nil {
+			return err
+		}
+	}
+	if p.keyword("HAVING") {
+		if q.Having, err = p.parseExpr(); err != nil {
+			return err
+		}
+	}
+	if p.keyword("ORDER") {
+		if err := p.expectKeyword("BY"); err != nil {
+			return err
+		}
+		names, err := p.parseOrderTerms()
+		if err != nil {
+			return err
+		}
+		q.OrderBy = names
+	}
+	if p.keyword("LIMIT") {
+		if q.Limit, err = p.parseCount(); err != nil {
+			return err
+		}
+	}
+	if p.keyword("OFFSET") {
+		if q.Offset, err = p.parseCount(); err != nil {
+			return err
+		}
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return p.errorf(t, "unexpected %q", t.text)
+	}
+	return nil
+}
+
+func (p *parser) parseNames() ([]string, error) {
+	var names []string
+	for {
+		t := p.next()
+		if t.kind != tokIdent {
+			return nil, p.errorf(t, "expected column name, found %q", t.text)
+		}
+		names = append(names, t.text)
+		if !p.op(",") {
+			return names, nil
+		}
+	}
+}
+
+func (p *parser) parseOrderTerms() ([]OrderTerm, error) {
+	var terms []OrderTerm
+	for {
+		t := p.next()
+		if t.kind != tokIdent {
+			return nil, p.errorf(t, "expected column name, found %q", t.text)
+		}
+		term := OrderTerm{Column: t.text}
+		if p.keyword("DESC") {
+			term.Desc = true
+		} else {
+			p.keyword("ASC")
+		}
+		terms = append(terms, term)
+		if !p.op(",") {
+			return terms, nil
+		}
+	}
+}
+
+func (p *parser) parseCount() (int, error) {
+	t := p.next()
+	n, err := strconv.Atoi(t.text)
+	if t.kind != tokNumber || err != nil || n < 0 {
+		return 0, p.errorf(t, "expected a count, found %q", t.text)
+	}
+	return n, nil
+}
+
+func (p *parser) parseExpr() (Expr, error) {
+	return p.parseOr()
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	e, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	if !p.isKeyword(0, "OR") {
+		return e, nil
+	}
+	or := &Or{Exprs: []Expr{e}}
+	for p.keyword("OR") {
+		e, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		or.Exprs = append(or.Exprs, e)
+	}
+	return or, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	e, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	if !p.isKeyword(0, "AND") {
+		return e, nil
+	}
+	and := &And{Exprs: []Expr{e}}
+	for p.keyword("AND") {
+		e, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		and.Exprs = append(and.Exprs, e)
+	}
+	return and, nil
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if p.keyword("NOT") {
+		e, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: e}, nil
+	}
+	return p.parsePredicate()
+}
+
+// parsePredicate parses a comparison, IN, BETWEEN, LIKE or IS [NOT] NULL test.
+func (p *parser) parsePredicate() (Expr, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind == tokOp {
+		switch t.text {
+		case "=", "!=", "<>", "<", "<=", ">", ">=":
+			p.pos++
+			right, err := p.parseAdditive()
+			if err != nil {
+				return nil, err
+			}
+			return &Binary{Op: t.text, Left: left, Right: right}, nil
+		}
+	}
+	if p.keyword("IS") {
+		not := p.keyword("NOT")
+		if err := p.expectKeyword("NULL"); err != nil {
+			return nil, err
+		}
+		return &IsNull{Expr: left, Not: not}, nil
+	}
+	not := false
+	if p.isKeyword(0, "NOT") && (p.isKeyword(1, "IN") || p.isKeyword(1, "BETWEEN") || p.isKeyword(1, "LIKE")) {
+		p.pos++
+		not = true
+	}
+	switch {
+	case p.keyword("LIKE"):
+		right, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		op := "LIKE"
+		if not {
+			op = "NOT LIKE"
+		}
+		return &Binary{Op: op, Left: left, Right: right}, nil
+	case p.keyword("IN"):
+		if err := p.expectOp("("); err != nil {
+			return nil, err
+		}
+		in := &In{Expr: left, Not: not}
+		for !p.op(")") {
+			if len(in.Values) > 0 {
+				if err := p.expectOp(","); err != nil {
+					return nil, err
+				}
+			}
+			v, err := p.parseAdditive()
+			if err != nil {
+				return nil, err
+			}
+			in.Values = append(in.Values, v)
+		}
+		return in, nil
+	case p.keyword("BETWEEN"):
+		lo, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectKeyword("AND"); err != nil {
+			return nil, err
+		}
+		hi, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		return &Between{Expr: left, Lo: lo, Hi: hi, Not: not}, nil
+	}
+	return left, nil
+}
+
+func (p *parser) parseAdditive() (Expr, error) {
+	e, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind != tokOp || t.text != "+" && t.text != "-" && t.text != "||" {
+			return e, nil
+		}
+		p.pos++
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		e = &Binary{Op: t.text, Left: e, Right: right}
+	}
+}
+
+func (p *parser) parseMultiplicative() (Expr, error) {
+	e, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind != tokOp || t.text != "*" && t.text != "/" && t.text != "%" {
+			return e, nil
+		}
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		e = &Binary{Op: t.text, Left: e, Right: right}
+	}
+}
+
+// parseUnary folds a leading minus into a number literal.
+func (p *parser) parseUnary() (Expr, error) {
+	if t := p.peek(); t.kind == tokOp && t.text == "-" && p.toks[p.pos+1].kind == tokNumber {
+		p.pos++
+		n := p.next()
+		return &Literal{Kind: Number, Value: "-" + n.text}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	t := p.next()
+	switch t.kind {
+	case tokString:
+		return &Literal{Kind: String, Value: t.text}, nil
+	case tokNumber:
+		return &Literal{Kind: Number, Value: t.text}, nil
+	case tokOp:
+		if t.text == "(" {
+			e, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectOp(")"); err != nil {
+				return nil, err
+			}
+			return e, nil
+		}
+	case tokIdent:
+		switch strings.ToUpper(t.text) {
+		case "NULL":
+			return &Literal{Kind: Null, Value: "NULL"}, nil
+		case "TRUE", "FALSE":
+			return &Literal{Kind: Bool, Value: strings.ToLower(t.text)}, nil
+		case "AND", "OR", "NOT", "IN", "BETWEEN", "LIKE", "IS":
+			return nil, p.errorf(t, "unexpected %s", strings.ToUpper(t.text))
+		}
+		if p.op("(") {
+			return p.parseCall(t.text)
+		}
+		return &Ident{Name: t.text}, nil
+	case tokEOF:
+		return nil, p.errorf(t, "unexpected end of input")
+	}
+	return nil, p.errorf(t, "unexpected %q", t.text)
+}
+
+// parseCall parses the arguments of a call after its opening parenthesis.
+func (p *parser) parseCall(name string) (Expr, error) {
+	call := &Call{Name: name, Args: []Expr{}}
+	if p.op("*") {
+		call.Args = append(call.Args, &Ident{Name: "*"})
+		return call, p.expectOp(")")
+	}
+	for !p.op(")") {
+		if len(call.Args) > 0 {
+			if err := p.expectOp(","); err != nil {
+				return nil, err
+			}
+		}
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		call.Args = append(call.Args, arg)
+	}
+	return call, nil
+}
@@ -0,0 +1,140 @@
+package godb
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// minRefreshDelay bounds how often a failing token refresh is retried.
+const minRefreshDelay = 5 * time.Second
+
+// Token is an access token issued by Login.
+type Token struct {
+	Value     string
+	ExpiresAt time.Time
+}
+
+// Login exchanges a username and password for an access token. The client sends
+// the token as gRPC metadata on every later call and refreshes it in the
+// background before it expires, so passwords need not travel with each request.
+func (c *GoDBClient) Login(ctx context.Context, username, password string) (_ *Token, err error) {
+	defer wrapOpError(&err, "Login", "", proto.DatabaseService_Login_FullMethodName, time.Now())
+	resp, err := c.client.Login(ctx, &proto.LoginRequest{Username: username, Password: password})
+	if err != nil {
+		return nil, err
+	}
+	tok := tokenFromResponse(resp)
+	c.auth.set(tok)
+	c.auth.startRefresh(c.client)
+	return tok, nil
+}
+
+// Logout forgets the access token and stops refreshing it.
+func (c *GoDBClient) Logout() {
+	c.auth.stopRefresh()
+	c.auth.set(nil)
+}
+
+// tokenFromResponse converts a LoginResponse.
+func tokenFromResponse(resp *proto.LoginResponse) *Token {
+	tok := &Token{Value: resp.Token}
+	if resp.ExpiresAt > 0 {
+		tok.ExpiresAt = time.Unix(resp.ExpiresAt, 0)
+	}
+	return tok
+}
+
+// authState holds the current access token and its refresher.
+type authState struct {
+	mu    sync.Mutex
+	token *Token
+	stop  chan struct{}
+	done  chan struct{}
+}
+
+// set replaces the current token.
+func (a *authState) set(tok *Token) {
+	a.mu.Lock()
+	a.token = tok
+	a.mu.Unlock()
+}
+
+// current returns the current token, or nil.
+func (a *authState) current() *Token {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.token
+}
+
+// startRefresh launches the background refresher, replacing any running one.
+func (a *authState) startRefresh(client proto.DatabaseServiceClient) {
+	a.stopRefresh()
+	a.mu.Lock()
+	stop, done := make(chan struct{}), make(chan struct{})
+	a.stop, a.done = stop, done
+	a.mu.Unlock()
+	go a.refreshLoop(client, stop, done)
+}
+
+// stopRefresh stops the refresher and waits for it to exit.
+func (a *authState) stopRefresh() {
+	a.mu.Lock()
+	stop, done := a.stop, a.done
+	a.stop, a.done = nil, nil
+	a.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+}
+
+// refreshLoop renews the token when 80% of its lifetime has passed, retrying
+// failures until the token expires.
+func (a *authState) refreshLoop(client proto.DatabaseServiceClient, stop, done chan struct{}) {
+	defer close(done)
+	for {
+		tok := a.current()
+		if tok == nil || tok.ExpiresAt.IsZero() || time.Now().After(tok.ExpiresAt) {
+			return
+		}
+		wait := time.Until(tok.ExpiresAt) * 4 / 5
+		if wait < minRefreshDelay {
+			wait = minRefreshDelay
+		}
+		select {
+		case <-stop:
+			return
+		case <-time.After(wait):
+		}
+		ctx, cancel := context.WithDeadline(context.Background(), tok.ExpiresAt.Add(minRefreshDelay))
+		resp, err := client.RefreshToken(ctx, &proto.RefreshTokenRequest{Token: tok.Value})
+		cancel()
+		if err == nil {
+			a.set(tokenFromResponse(resp))
+		}
+	}
+}
+
+// attach adds the current token, if any, to the outgoing metadata.
+func (a *authState) attach(ctx context.Context) context.Context {
+	if tok := a.current(); tok != nil {
+		return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+tok.Value)
+	}
+	return ctx
+}
+
+// unaryInterceptor attaches the token to unary calls.
+func (a *authState) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(a.attach(ctx), method, req, reply, cc, opts...)
+}
+
+// streamInterceptor attaches the token to streams.
+func (a *authState) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(a.attach(ctx), desc, cc, method, opts...)
+}
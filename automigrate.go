@@ -0,0 +1,308 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// Tabler can be implemented by models to override the derived table name.
+type Tabler interface {
+	TableName() string
+}
+
+// AutoMigrate creates missing tables and adds missing columns for each model,
+// using the client's stored connection string. Table names come from TableName()
+// when the model implements Tabler, otherwise from the pluralized snake_case type
+// name. Column types come from the `type=` tag option, falling back to a type
+// derived from the Go field type, or JSON for fields with the `json` option; the
+// `pk` option marks the primary key.
+//
+// The `index` and `unique` options declare an index on the column, named
+// idx_<table>_<column> or uniq_<table>_<column>. Give fields the same name,
+// as in `index=idx_users_name`, to build one index over several columns in
+// field order. Missing indexes are created; indexes that differ from their
+// declaration are left alone and reported by IndexDrift.
+func (c *GoDBClient) AutoMigrate(ctx context.Context, models ...interface{}) error {
+	for _, model := range models {
+		if err := c.autoMigrateModel(ctx, model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// autoMigrateModel brings the table for a single model up to date.
+func (c *GoDBClient) autoMigrateModel(ctx context.Context, model interface{}) (err error) {
+	defer wrapOpError(&err, "AutoMigrate", "", "", time.Now())
+	rv, err := structValue(model)
+	if err != nil {
+		return err
+	}
+	table := modelTableName(model, rv.Type())
+	columns := modelColumns(rv.Type())
+
+	// Describe without the pinned fingerprint check: the pin is the schema
+	// the migration is about to produce.
+	desc, err := c.client.DescribeTable(ctx, &proto.DescribeTableRequest{
+		TableName:        table,
+		ConnectionString: c.connectionStringFor(ctx),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe table %s: %w", table, err)
+	}
+	if !desc.Exists {
+		if _, err := c.CreateTable(ctx, table, columns, c.connectionStringFor(ctx)); err != nil {
+			return fmt.Errorf("failed to create table %s: %w", table, err)
+		}
+		return c.migrateIndexes(ctx, table, rv.Type())
+	}
+
+	existing := make(map[string]bool, len(desc.Columns))
+	for _, col := range desc.Columns {
+		existing[col.Name] = true
+	}
+	update := c.UpdateTable(ctx).Table(table)
+	var missing []string
+	for _, f := range modelFields(rv.Type()) {
+		if !existing[f.column] {
+			update.AddColumn(f.column, columns[f.column])
+			missing = append(missing, f.column)
+		}
+	}
+	if len(missing) > 0 {
+		if _, err := update.Exec(); err != nil {
+			return fmt.Errorf("failed to add columns %s to %s: %w", strings.Join(missing, ", "), table, err)
+		}
+	}
+	return c.migrateIndexes(ctx, table, rv.Type())
+}
+
+// migrateIndexes creates the declared indexes of a model that do not exist yet.
+func (c *GoDBClient) migrateIndexes(ctx context.Context, table string, t reflect.Type) error {
+	declared := modelIndexes(table, t)
+	if len(declared) == 0 {
+		return nil
+	}
+	drift, err := c.indexDrift(ctx, table, declared)
+	if err != nil {
+		return err
+	}
+	for _, d := range drift {
+		if d.Kind != IndexMissing {
+			continue
+		}
+		if _, err := c.addIndex(ctx, &proto.AddIndexRequest{
+			TableName:        table,
+			IndexName:        d.Index,
+			Columns:          d.Declared,
+			Unique:           d.Unique,
+			ConnectionString: c.connectionStringFor(ctx),
+		}); err != nil {
+			return fmt.Errorf("failed to add index %s to %s: %w", d.Index, table, err)
+		}
+	}
+	return nil
+}
+
+// IndexDriftKind says how an index differs from its model declaration.
+type IndexDriftKind string
+
+// Kinds of index drift.
+const (
+	IndexMissing IndexDriftKind = "missing" // declared but not in the database
+	IndexChanged IndexDriftKind = "changed" // columns or uniqueness differ
+	IndexExtra   IndexDriftKind = "extra"   // in the database but not declared
+)
+
+// IndexDrift is a difference between the indexes a model declares and the
+// indexes its table has.
+type IndexDrift struct {
+	Table    string
+	Index    string
+	Kind     IndexDriftKind
+	Declared []string // columns in the model; nil for IndexExtra
+	Actual   []string // columns in the database; nil for IndexMissing
+	Unique   bool     // whether the declared index is unique
+}
+
+// IndexDrift compares the indexes declared by each model's tags, as described
+// on AutoMigrate, with those reported by ListIndexes. Nothing is changed.
+func (c *GoDBClient) IndexDrift(ctx context.Context, models ...interface{}) (_ []IndexDrift, err error) {
+	defer wrapOpError(&err, "IndexDrift", "", "", time.Now())
+	var drift []IndexDrift
+	for _, model := range models {
+		rv, err := structValue(model)
+		if err != nil {
+			return nil, err
+		}
+		table := modelTableName(model, rv.Type())
+		d, err := c.indexDrift(ctx, table, modelIndexes(table, rv.Type()))
+		if err != nil {
+			return nil, err
+		}
+		drift = append(drift, d...)
+	}
+	return drift, nil
+}
+
+// indexDrift compares declared with the table's indexes.
+func (c *GoDBClient) indexDrift(ctx context.Context, table string, declared []modelIndex) ([]IndexDrift, error) {
+	resp, err := c.client.ListIndexes(ctx, &proto.ListIndexesRequest{ConnectionString: c.connectionStringFor(ctx)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexes: %w", err)
+	}
+	actual := make(map[string]*proto.Index)
+	for _, idx := range resp.Indexes {
+		if idx.TableName == table {
+			actual[idx.IndexName] = idx
+		}
+	}
+	var drift []IndexDrift
+	for _, want := range declared {
+		d := IndexDrift{Table: table, Index: want.name, Declared: want.columns, Unique: want.unique}
+		have, ok := actual[want.name]
+		delete(actual, want.name)
+		if !ok {
+			d.Kind = IndexMissing
+			drift = append(drift, d)
+			continue
+		}
+		d.Actual = splitIndexColumns(have.Columns)
+		if have.Unique != want.unique || strings.Join(d.Actual, ",") != strings.Join(want.columns, ",") {
+			d.Kind = IndexChanged
+			drift = append(drift, d)
+		}
+	}
+	for _, idx := range resp.Indexes {
+		if _, extra := actual[idx.IndexName]; extra && idx.TableName == table {
+			drift = append(drift, IndexDrift{
+				Table:  table,
+				Index:  idx.IndexName,
+				Kind:   IndexExtra,
+				Actual: splitIndexColumns(idx.Columns),
+			})
+		}
+	}
+	return drift, nil
+}
+
+// splitIndexColumns splits the comma-separated column list of an index.
+func splitIndexColumns(columns string) []string {
+	var out []string
+	for _, col := range strings.Split(columns, ",") {
+		if col = strings.TrimSpace(col); col != "" {
+			out = append(out, col)
+		}
+	}
+	return out
+}
+
+// modelIndex is an index declared by `index` or `unique` tag options.
+type modelIndex struct {
+	name    string
+	columns []string
+	unique  bool
+}
+
+// modelIndexes collects the indexes declared by a model type, in field order.
+func modelIndexes(table string, t reflect.Type) []modelIndex {
+	var indexes []modelIndex
+	byName := make(map[string]int)
+	add := func(name, column string, unique bool) {
+		if i, ok := byName[name]; ok {
+			indexes[i].columns = append(indexes[i].columns, column)
+			indexes[i].unique = indexes[i].unique || unique
+			return
+		}
+		byName[name] = len(indexes)
+		indexes = append(indexes, modelIndex{name: name, columns: []string{column}, unique: unique})
+	}
+	for _, f := range modelFields(t) {
+		for _, opt := range f.options {
+			key, name, _ := strings.Cut(opt, "=")
+			switch key {
+			case "index":
+				if name == "" && f.hasOption("unique") {
+					// A unique index already serves lookups on the column.
+					continue
+				}
+				if name == "" {
+					name = "idx_" + table + "_" + f.column
+				}
+				add(name, f.column, false)
+			case "unique":
+				if name == "" {
+					name = "uniq_" + table + "_" + f.column
+				}
+				add(name, f.column, true)
+			}
+		}
+	}
+	return indexes
+}
+
+// modelTableName derives the table name for a model.
+func modelTableName(model interface{}, t reflect.Type) string {
+	if tabler, ok := model.(Tabler); ok {
+		return tabler.TableName()
+	}
+	name := snakeCase(t.Name())
+	if strings.HasSuffix(name, "s") {
+		return name + "es"
+	}
+	return name + "s"
+}
+
+// modelColumns derives the column definitions for a model type.
+func modelColumns(t reflect.Type) map[string]string {
+	columns := make(map[string]string)
+	for _, f := range modelFields(t) {
+		colType, ok := f.optionValue("type")
+		switch {
+		case ok:
+		case f.hasOption("json"):
+			colType = string(JSON)
+		default:
+			colType = columnTypeFor(t.FieldByIndex(f.index).Type)
+		}
+		if f.hasOption("pk") {
+			colType += " PRIMARY KEY"
+		}
+		columns[f.column] = colType
+	}
+	return columns
+}
+
+// columnTypeFor maps a Go type to a column type.
+func columnTypeFor(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		if _, ok := lookupCodec(t); !ok {
+			t = t.Elem()
+		}
+	}
+	if c, ok := lookupCodec(t); ok {
+		if c.unquoted {
+			return "NUMERIC"
+		}
+		return "TEXT"
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return string(Bool)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "INTEGER"
+	case reflect.Float32, reflect.Float64:
+		return "REAL"
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "BLOB"
+		}
+	}
+	return "TEXT"
+}
@@ -0,0 +1,88 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// backupChunkSize is the size of the chunks Restore sends.
+const backupChunkSize = 64 << 10
+
+// Backup streams a snapshot of the client's database to w and returns the
+// number of bytes written. The snapshot is opaque and portable: Restore loads
+// it into any GoDB server. Writes made while the backup runs may or may not be
+// included, depending on the server.
+func (c *GoDBClient) Backup(ctx context.Context, w io.Writer) (_ int64, err error) {
+	defer wrapOpError(&err, "Backup", "", proto.DatabaseService_Backup_FullMethodName, time.Now())
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.client.Backup(ctx, &proto.BackupRequest{ConnectionString: c.connectionStringFor(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	var written int64
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return written, nil
+		}
+		if err != nil {
+			return written, err
+		}
+		n, err := w.Write(chunk.Data)
+		written += int64(n)
+		if err != nil {
+			return written, fmt.Errorf("write backup: %w", err)
+		}
+	}
+}
+
+// Restore loads a snapshot written by Backup from r into the client's
+// database, replacing its contents. Cached results for the database are
+// invalidated once the restore succeeds.
+func (c *GoDBClient) Restore(ctx context.Context, r io.Reader) (err error) {
+	defer wrapOpError(&err, "Restore", "", proto.DatabaseService_Restore_FullMethodName, time.Now())
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.client.Restore(ctx)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, backupChunkSize)
+	first := true
+	for {
+		n, rerr := io.ReadFull(r, buf)
+		if n > 0 || first {
+			chunk := &proto.RestoreChunk{Data: buf[:n]}
+			if first {
+				chunk.ConnectionString = c.connectionStringFor(ctx)
+				first = false
+			}
+			if err := stream.Send(chunk); err != nil {
+				if err == io.EOF {
+					// The server ended the stream; its status says why.
+					_, err = stream.CloseAndRecv()
+				}
+				return err
+			}
+		}
+		if rerr == io.EOF || rerr == io.ErrUnexpectedEOF {
+			break
+		}
+		if rerr != nil {
+			return fmt.Errorf("read backup: %w", rerr)
+		}
+	}
+	if _, err = stream.CloseAndRecv(); err != nil {
+		return err
+	}
+	if c.cache != nil {
+		// Every table of the database may have changed.
+		c.cache.invalidate(ctx, connGenKey(c.connectionStringFor(ctx)))
+	}
+	return nil
+}
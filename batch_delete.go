@@ -0,0 +1,59 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// DeleteInBatches deletes the rows of table matching cond batchSize rows at a
+// time, sleeping for pause between batches so the table is never locked for
+// long. Rows are selected by their "id" column. onProgress, if not nil, is
+// called after each batch with the running total. It returns the number of rows
+// the server reports deleted, which is also returned when ctx is cancelled or a
+// batch fails part way.
+func (c *GoDBClient) DeleteInBatches(ctx context.Context, table string, cond Cond, batchSize int, pause time.Duration, onProgress func(deleted int)) (deleted int, err error) {
+	defer wrapOpError(&err, "DeleteInBatches", table, "", time.Now())
+	if batchSize <= 0 {
+		return 0, fmt.Errorf("batch size must be positive")
+	}
+	if cond == nil {
+		return 0, fmt.Errorf("condition is required")
+	}
+	for {
+		resp, err := c.Query(ctx).Table(table).Columns("id").Where(cond).Limit(batchSize).Exec()
+		if err != nil {
+			return deleted, err
+		}
+		if len(resp.Rows) == 0 {
+			return deleted, nil
+		}
+		ids := make([]interface{}, 0, len(resp.Rows))
+		for _, row := range resp.Rows {
+			id := row.Data["id"]
+			if n, err := strconv.ParseInt(id, 10, 64); err == nil {
+				ids = append(ids, n)
+			} else {
+				ids = append(ids, id)
+			}
+		}
+		// Repeat cond so rows changed since the query are left alone.
+		n, err := c.Delete(ctx).Table(table).Where(cond).Where(In("id", ids...)).ExecCount()
+		if err != nil {
+			return deleted, err
+		}
+		deleted += int(n)
+		if onProgress != nil {
+			onProgress(deleted)
+		}
+		if len(resp.Rows) < batchSize {
+			return deleted, nil
+		}
+		select {
+		case <-ctx.Done():
+			return deleted, ctx.Err()
+		case <-time.After(pause):
+		}
+	}
+}
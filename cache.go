@@ -0,0 +1,278 @@
+package godb
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// WithCache caches query results and table schemas for ttl. Writes made through
+// the client invalidate the cached entries of the affected table; writes made by
+// other clients not sharing the cache become visible once the entries expire.
+// Entries are kept in a MemoryCache unless WithCacheBackend is also given.
+func WithCache(ttl time.Duration) Option {
+	return func(o *clientOptions) {
+		o.cacheTTL = ttl
+	}
+}
+
+// WithCacheBackend stores cached entries in c, e.g. a RedisCache shared by
+// several instances. It takes effect together with WithCache.
+func WithCacheBackend(c Cache) Option {
+	return func(o *clientOptions) {
+		o.cacheBackend = c
+	}
+}
+
+// Preload runs queries with ctx and describes each queried table, populating the
+// query and schema caches so the first requests after startup are served warm.
+// It requires WithCache.
+func (c *GoDBClient) Preload(ctx context.Context, queries []*QueryBuilder) error {
+	if c.cache == nil {
+		return fmt.Errorf("preload requires the WithCache option")
+	}
+	described := make(map[string]bool)
+	for _, qb := range queries {
+		q := *qb
+		q.ctx = ctx
+		if _, err := q.Exec(); err != nil {
+			return err
+		}
+		if q.tableName == "" || described[q.tableName] {
+			continue
+		}
+		described[q.tableName] = true
+		if _, err := c.DescribeTable(ctx, q.tableName, q.connectionString); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeMethods are the RPCs that change a table's rows or schema.
+var writeMethods = map[string]bool{
+	proto.DatabaseService_CreateTable_FullMethodName:           true,
+	proto.DatabaseService_InsertRecord_FullMethodName:          true,
+	proto.DatabaseService_InsertMultipleRecords_FullMethodName: true,
+	proto.DatabaseService_UpsertRecord_FullMethodName:          true,
+	proto.DatabaseService_UpdateRecord_FullMethodName:          true,
+	proto.DatabaseService_DeleteRecord_FullMethodName:          true,
+	proto.DatabaseService_UpdateTable_FullMethodName:           true,
+	proto.DatabaseService_CopyTable_FullMethodName:             true,
+	proto.DatabaseService_TruncateTable_FullMethodName:         true,
+}
+
+// schemaMethods are the write methods that change a table's schema.
+var schemaMethods = map[string]bool{
+	proto.DatabaseService_CreateTable_FullMethodName: true,
+	proto.DatabaseService_UpdateTable_FullMethodName: true,
+	proto.DatabaseService_CopyTable_FullMethodName:   true,
+}
+
+// tableRequest is implemented by every request naming a table.
+type tableRequest interface {
+	GetTableName() string
+	GetConnectionString() string
+}
+
+// Cache stores query results and table schemas. Implementations must be safe
+// for concurrent use. A Cache shared by several clients, such as RedisCache,
+// lets them share results and see each other's invalidations.
+type Cache interface {
+	// Get returns the value stored under key and whether it was found.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores value under key for ttl, or without expiry when ttl is zero.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes key.
+	Delete(ctx context.Context, key string) error
+}
+
+// clientCache caches responses in a Cache. Each table has generation keys for
+// its rows and its schema; entries are stored under the current generation, so
+// invalidating a table only needs to move its generation on. Each connection
+// string also has a generation, moved on to invalidate all of its tables.
+type clientCache struct {
+	store Cache
+	ttl   time.Duration
+}
+
+// interceptor serves QueryData and DescribeTable calls from the cache and
+// invalidates a table's entries after a successful write to it. Cache failures
+// never fail a call; the request simply goes to the server.
+func (cc *clientCache) interceptor(ctx context.Context, method string, req, reply interface{}, conn *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	tr, ok := req.(tableRequest)
+	if !ok {
+		return invoker(ctx, method, req, reply, conn, opts...)
+	}
+	if q, ok := req.(*proto.QueryDataRequest); ok && q.WantChecksum {
+		// Critical reads always go to the server.
+		return invoker(ctx, method, req, reply, conn, opts...)
+	}
+	if _, inTx := TxFromContext(ctx); inTx && !writeMethods[method] {
+		// Reads inside a transaction see its uncommitted writes.
+		return invoker(ctx, method, req, reply, conn, opts...)
+	}
+	genKey := "godb:gen:" + hashKey(tr.GetConnectionString(), tr.GetTableName())
+	schemaGenKey := "godb:schemagen:" + hashKey(tr.GetConnectionString(), tr.GetTableName())
+	if writeMethods[method] {
+		err := invoker(ctx, method, req, reply, conn, opts...)
+		if err == nil {
+			keys := []string{genKey}
+			if schemaMethods[method] {
+				keys = append(keys, schemaGenKey)
+			}
+			for _, key := range keys {
+				cc.invalidate(ctx, key)
+			}
+			if tx, inTx := TxFromContext(ctx); inTx {
+				// Reads outside the transaction may cache the old rows until it
+				// commits, so invalidate again when it finishes.
+				tx.afterFinish(func(ctx context.Context) {
+					for _, key := range keys {
+						cc.invalidate(ctx, key)
+					}
+				})
+			}
+		}
+		return err
+	}
+	switch method {
+	case proto.DatabaseService_QueryData_FullMethodName:
+	case proto.DatabaseService_DescribeTable_FullMethodName:
+		// Row writes leave the schema alone, so it has its own generation.
+		genKey = schemaGenKey
+	default:
+		return invoker(ctx, method, req, reply, conn, opts...)
+	}
+	encoded, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(req.(protobuf.Message))
+	if err != nil {
+		return invoker(ctx, method, req, reply, conn, opts...)
+	}
+	gen, _, err := cc.store.Get(ctx, genKey)
+	if err != nil {
+		return invoker(ctx, method, req, reply, conn, opts...)
+	}
+	connGen, _, err := cc.store.Get(ctx, connGenKey(tr.GetConnectionString()))
+	if err != nil {
+		return invoker(ctx, method, req, reply, conn, opts...)
+	}
+	key := "godb:entry:" + hashKey(string(connGen), string(gen), method, string(encoded))
+	out := reply.(protobuf.Message)
+	if cached, ok, err := cc.store.Get(ctx, key); err == nil && ok {
+		if err := protobuf.Unmarshal(cached, out); err == nil {
+			return nil
+		}
+		protobuf.Reset(out)
+	}
+	if err := invoker(ctx, method, req, reply, conn, opts...); err != nil {
+		return err
+	}
+	if value, err := protobuf.Marshal(out); err == nil {
+		cc.store.Set(ctx, key, value, cc.ttl)
+	}
+	return nil
+}
+
+// invalidate moves a table's generation on, orphaning its cached entries until
+// they expire.
+func (cc *clientCache) invalidate(ctx context.Context, genKey string) {
+	gen := make([]byte, 16)
+	rand.Read(gen)
+	cc.store.Set(ctx, genKey, []byte(hex.EncodeToString(gen)), 0)
+}
+
+// connGenKey returns the generation key of every table of connStr.
+func connGenKey(connStr string) string {
+	return "godb:conngen:" + hashKey(connStr)
+}
+
+// hashKey returns a fixed-length key for the given parts.
+func hashKey(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// MemoryCache is an in-process Cache.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+	swept   int
+}
+
+// memoryEntry is a cached value and its expiry; a zero expiry never expires.
+type memoryEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache returns an empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]memoryEntry)}
+}
+
+// Get implements Cache.
+func (m *MemoryCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if !e.expires.IsZero() && time.Now().After(e.expires) {
+		delete(m.entries, key)
+		return nil, false, nil
+	}
+	return e.value, true, nil
+}
+
+// Set implements Cache.
+func (m *MemoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	e := memoryEntry{value: value}
+	if ttl > 0 {
+		e.expires = time.Now().Add(ttl)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = e
+	m.evictExpired()
+	return nil
+}
+
+// Delete implements Cache.
+func (m *MemoryCache) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	delete(m.entries, key)
+	m.mu.Unlock()
+	return nil
+}
+
+// evictExpired drops expired entries once the cache has doubled in size since
+// the last sweep, keeping orphaned entries from accumulating.
+func (m *MemoryCache) evictExpired() {
+	if len(m.entries) < 2*m.swept {
+		return
+	}
+	now := time.Now()
+	for k, e := range m.entries {
+		if !e.expires.IsZero() && now.After(e.expires) {
+			delete(m.entries, k)
+		}
+	}
+	m.swept = len(m.entries)
+	if m.swept < 64 {
+		m.swept = 64
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"unicode/utf8"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+)
+
+// fileFormat returns the ExportFormat named by f.
+func fileFormat(f string) (godb.ExportFormat, error) {
+	switch f {
+	case "csv":
+		return godb.CSV, nil
+	case "json":
+		return godb.JSONLines, nil
+	}
+	return 0, usageError{}
+}
+
+// delimiter returns the single character of d.
+func delimiter(d string) (rune, error) {
+	r, size := utf8.DecodeRuneInString(d)
+	if size == 0 || size != len(d) {
+		return 0, usageError{}
+	}
+	return r, nil
+}
+
+// runExport writes a table, or the rows of it matching -where, to a file or
+// standard output.
+func runExport(ctx context.Context, client *godb.GoDBClient, args []string) (err error) {
+	fs := newFlags("export")
+	where := fs.String("where", "", "condition selecting the rows to export")
+	format := fs.String("format", "csv", "output format: csv or json (one object per line)")
+	delim := fs.String("delim", ",", "CSV field delimiter")
+	gzip := fs.Bool("gzip", false, "gzip the output")
+	out := fs.String("o", "", "output file; standard output if empty")
+	if err := parseFlags(fs, args, 1, 1); err != nil {
+		return err
+	}
+	f, err := fileFormat(*format)
+	if err != nil {
+		return err
+	}
+	d, err := delimiter(*delim)
+	if err != nil {
+		return err
+	}
+	eb := client.Export(ctx).Format(f).Delimiter(d)
+	if *where != "" {
+		eb.Query(client.Query(ctx).Table(fs.Arg(0)).Condition(*where))
+	} else {
+		eb.Table(fs.Arg(0))
+	}
+	if *gzip {
+		eb.Gzip()
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := file.Close(); err == nil {
+				err = cerr
+			}
+		}()
+		w = file
+	}
+	n, err := eb.To(w)
+	if err != nil {
+		return err
+	}
+	if *out != "" {
+		fmt.Fprintf(os.Stderr, "exported %d rows to %s\n", n, *out)
+	}
+	return nil
+}
+
+// runImport loads a file, or standard input, into a table. Rows that fail are
+// reported and skipped.
+func runImport(ctx context.Context, client *godb.GoDBClient, args []string) error {
+	fs := newFlags("import")
+	format := fs.String("format", "csv", "input format: csv or json (one object per line)")
+	delim := fs.String("delim", ",", "CSV field delimiter")
+	gzip := fs.Bool("gzip", false, "the input is gzipped")
+	infer := fs.Bool("infer", false, "create the table from the input if it does not exist")
+	batch := fs.Int("batch", 500, "rows per insert request")
+	if err := parseFlags(fs, args, 1, 2); err != nil {
+		return err
+	}
+	f, err := fileFormat(*format)
+	if err != nil {
+		return err
+	}
+	d, err := delimiter(*delim)
+	if err != nil {
+		return err
+	}
+
+	var r io.Reader = os.Stdin
+	if fs.NArg() == 2 {
+		file, err := os.Open(fs.Arg(1))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		r = file
+	}
+	failed := 0
+	ib := client.Import(ctx).
+		Table(fs.Arg(0)).
+		From(r).
+		Format(f).
+		Delimiter(d).
+		BatchSize(*batch).
+		OnError(func(e godb.ImportRowError) {
+			failed++
+			fmt.Fprintf(os.Stderr, "row %d: %v\n", e.Row, e.Err)
+		})
+	if *gzip {
+		ib.Gzip()
+	}
+	if *infer {
+		ib.InferSchema()
+	}
+	n, err := ib.Exec()
+	fmt.Fprintf(os.Stderr, "imported %d rows, %d failed\n", n, failed)
+	return err
+}
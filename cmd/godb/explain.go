@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+	"github.com/prakhar-5447/GoDB_SDK_GO/ast"
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// runExplain prints the plan of a SELECT statement as a tree, followed by the
+// usage of each index the plan reads.
+func runExplain(ctx context.Context, client *godb.GoDBClient, args []string) error {
+	fs := newFlags("explain")
+	stats := fs.Bool("stats", true, "print the usage statistics of the indexes the plan reads")
+	if err := parseFlags(fs, args, 1, 1); err != nil {
+		return err
+	}
+	return explain(ctx, client, fs.Arg(0), *stats, os.Stdout)
+}
+
+// explain parses a SELECT statement and writes its plan to w.
+func explain(ctx context.Context, client *godb.GoDBClient, text string, stats bool, w io.Writer) error {
+	q, err := ast.Parse(strings.TrimSuffix(strings.TrimSpace(text), ";"))
+	if err != nil {
+		return err
+	}
+	if q.Table == "" {
+		return fmt.Errorf("want a SELECT statement with a FROM clause")
+	}
+	plan, err := client.Explain(ctx, client.QueryFromAST(ctx, q))
+	if err != nil {
+		return err
+	}
+	if plan == nil {
+		return fmt.Errorf("the server returned no plan")
+	}
+	printPlan(w, plan, "", "")
+	if !stats {
+		return nil
+	}
+	return printIndexUsage(ctx, client, w, plan)
+}
+
+// printPlan writes node and its children as an ASCII tree. first prefixes the
+// node's own line and rest the lines below it.
+func printPlan(w io.Writer, node *proto.QueryPlan, first, rest string) {
+	line := node.ScanType
+	if node.TableName != "" {
+		line += " " + node.TableName
+	}
+	if node.IndexName != "" {
+		line += " using " + node.IndexName
+	}
+	line += fmt.Sprintf("  (rows=%d)", node.EstimatedRows)
+	if node.Detail != "" {
+		line += "  " + node.Detail
+	}
+	fmt.Fprintln(w, first+line)
+	for i, child := range node.Children {
+		if i == len(node.Children)-1 {
+			printPlan(w, child, rest+"└── ", rest+"    ")
+		} else {
+			printPlan(w, child, rest+"├── ", rest+"│   ")
+		}
+	}
+}
+
+// printIndexUsage writes the server's statistics for each index read by plan.
+func printIndexUsage(ctx context.Context, client *godb.GoDBClient, w io.Writer, plan *proto.QueryPlan) error {
+	used := make(map[string]map[string]bool) // table -> indexes
+	var tables []string
+	var walk func(*proto.QueryPlan)
+	walk = func(node *proto.QueryPlan) {
+		if node.IndexName != "" && node.TableName != "" {
+			if used[node.TableName] == nil {
+				used[node.TableName] = make(map[string]bool)
+				tables = append(tables, node.TableName)
+			}
+			used[node.TableName][node.IndexName] = true
+		}
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+	walk(plan)
+	if len(tables) == 0 {
+		fmt.Fprintln(w, "\nno indexes used")
+		return nil
+	}
+	fmt.Fprintln(w, "\nindex usage:")
+	for _, table := range tables {
+		stats, err := client.IndexStats(ctx, table)
+		if err != nil {
+			return err
+		}
+		for _, s := range stats {
+			if !used[table][s.IndexName] {
+				continue
+			}
+			last := "never"
+			if s.LastUsed > 0 {
+				last = time.Unix(s.LastUsed, 0).Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "  %s.%s (%s): %d scans, %d rows read, last used %s\n",
+				table, s.IndexName, strings.Join(s.Columns, ", "), s.Scans, s.RowsRead, last)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// maxHistory is the number of entries kept in a history file.
+const maxHistory = 1000
+
+// errInterrupted reports a line abandoned with Ctrl-C.
+var errInterrupted = errors.New("interrupted")
+
+// lineReader reads input lines after printing a prompt.
+type lineReader interface {
+	readLine(prompt string) (string, error)
+	// addHistory records an entry the user may recall.
+	addHistory(entry string)
+}
+
+// plainReader reads lines without editing, for input that is not a terminal.
+type plainReader struct {
+	in  *bufio.Scanner
+	out io.Writer
+}
+
+func newPlainReader(r io.Reader, w io.Writer) *plainReader {
+	in := bufio.NewScanner(r)
+	in.Buffer(nil, 1<<20)
+	return &plainReader{in: in, out: w}
+}
+
+func (p *plainReader) readLine(prompt string) (string, error) {
+	fmt.Fprint(p.out, prompt)
+	if !p.in.Scan() {
+		if err := p.in.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	}
+	return p.in.Text(), nil
+}
+
+func (p *plainReader) addHistory(string) {}
+
+// editor reads lines from a terminal with Emacs-style editing keys and a
+// history recalled with the arrow keys:
+//
+//	Left, Right, Ctrl-B, Ctrl-F   move a character
+//	Home, End, Ctrl-A, Ctrl-E     move to the start or end
+//	Backspace, Delete, Ctrl-D     delete a character; Ctrl-D on an empty line ends input
+//	Ctrl-U, Ctrl-K, Ctrl-W        delete to the start, to the end, or the previous word
+//	Up, Down, Ctrl-P, Ctrl-N      recall history
+//	Ctrl-C                        abandon the line
+//	Ctrl-L                        clear the screen
+type editor struct {
+	fd      int
+	in      *bufio.Reader
+	out     io.Writer
+	history []string
+	path    string // history file; empty keeps history in memory
+}
+
+func newEditor(fd int, r io.Reader, w io.Writer, historyPath string) *editor {
+	e := &editor{fd: fd, in: bufio.NewReader(r), out: w, path: historyPath}
+	if historyPath != "" {
+		if data, err := os.ReadFile(historyPath); err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				if line != "" {
+					e.history = append(e.history, line)
+				}
+			}
+		}
+	}
+	return e
+}
+
+// historyPath returns the history file of connStr in the user's config
+// directory. The name is a hash, so the file name does not reveal the password
+// in the connection string.
+func historyPath(connStr string) string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(connStr))
+	return filepath.Join(dir, "godb", "history", hex.EncodeToString(sum[:8]))
+}
+
+func (e *editor) addHistory(entry string) {
+	entry = strings.TrimSpace(entry)
+	if entry == "" || strings.ContainsAny(entry, "\r\n") {
+		return
+	}
+	if n := len(e.history); n > 0 && e.history[n-1] == entry {
+		return
+	}
+	e.history = append(e.history, entry)
+	if len(e.history) > maxHistory {
+		e.history = e.history[len(e.history)-maxHistory:]
+	}
+	if e.path == "" {
+		return
+	}
+	// History is a convenience; failing to save it is not worth an error.
+	if err := os.MkdirAll(filepath.Dir(e.path), 0o700); err == nil {
+		os.WriteFile(e.path, []byte(strings.Join(e.history, "\n")+"\n"), 0o600)
+	}
+}
+
+func (e *editor) readLine(prompt string) (string, error) {
+	restore, err := makeRaw(e.fd)
+	if err != nil {
+		return "", err
+	}
+	defer restore()
+
+	var line []rune
+	pos := 0
+	hist := len(e.history) // index of the entry shown; len(history) is the new line
+	var draft []rune       // the new line, kept while browsing history
+	redraw := func() {
+		fmt.Fprintf(e.out, "\r%s%s\x1b[K", prompt, string(line))
+		if back := len(line) - pos; back > 0 {
+			fmt.Fprintf(e.out, "\x1b[%dD", back)
+		}
+	}
+	recall := func(i int) {
+		if i < 0 || i > len(e.history) || i == hist {
+			return
+		}
+		if hist == len(e.history) {
+			draft = line
+		}
+		hist = i
+		if i == len(e.history) {
+			line = draft
+		} else {
+			line = []rune(e.history[i])
+		}
+		pos = len(line)
+		redraw()
+	}
+	redraw()
+	for {
+		r, _, err := e.in.ReadRune()
+		if err != nil {
+			return "", err
+		}
+		switch r {
+		case '\r', '\n':
+			fmt.Fprint(e.out, "\r\n")
+			return string(line), nil
+		case 3: // Ctrl-C
+			fmt.Fprint(e.out, "^C\r\n")
+			return "", errInterrupted
+		case 4: // Ctrl-D
+			if len(line) == 0 {
+				fmt.Fprint(e.out, "\r\n")
+				return "", io.EOF
+			}
+			if pos < len(line) {
+				line = append(line[:pos], line[pos+1:]...)
+			}
+		case 127, 8: // Backspace, Ctrl-H
+			if pos > 0 {
+				line = append(line[:pos-1], line[pos:]...)
+				pos--
+			}
+		case 1: // Ctrl-A
+			pos = 0
+		case 5: // Ctrl-E
+			pos = len(line)
+		case 2: // Ctrl-B
+			if pos > 0 {
+				pos--
+			}
+		case 6: // Ctrl-F
+			if pos < len(line) {
+				pos++
+			}
+		case 11: // Ctrl-K
+			line = line[:pos]
+		case 21: // Ctrl-U
+			line = append([]rune{}, line[pos:]...)
+			pos = 0
+		case 23: // Ctrl-W
+			start := pos
+			for start > 0 && unicode.IsSpace(line[start-1]) {
+				start--
+			}
+			for start > 0 && !unicode.IsSpace(line[start-1]) {
+				start--
+			}
+			line = append(line[:start], line[pos:]...)
+			pos = start
+		case 12: // Ctrl-L
+			fmt.Fprint(e.out, "\x1b[H\x1b[2J")
+		case 16: // Ctrl-P
+			recall(hist - 1)
+			continue
+		case 14: // Ctrl-N
+			recall(hist + 1)
+			continue
+		case 27: // escape sequence
+			switch e.escape() {
+			case "[A", "OA":
+				recall(hist - 1)
+				continue
+			case "[B", "OB":
+				recall(hist + 1)
+				continue
+			case "[C", "OC":
+				if pos < len(line) {
+					pos++
+				}
+			case "[D", "OD":
+				if pos > 0 {
+					pos--
+				}
+			case "[H", "OH", "[1~", "[7~":
+				pos = 0
+			case "[F", "OF", "[4~", "[8~":
+				pos = len(line)
+			case "[3~":
+				if pos < len(line) {
+					line = append(line[:pos], line[pos+1:]...)
+				}
+			}
+		default:
+			if unicode.IsPrint(r) || r == '\t' {
+				line = append(line[:pos], append([]rune{r}, line[pos:]...)...)
+				pos++
+			}
+		}
+		redraw()
+	}
+}
+
+// escape reads the rest of an escape sequence after ESC.
+func (e *editor) escape() string {
+	return readEscape(e.in)
+}
+
+// readEscape reads the rest of an escape sequence after ESC: a '[' or 'O',
+// then parameters, up to and including the final letter or '~'.
+func readEscape(in *bufio.Reader) string {
+	var b strings.Builder
+	for b.Len() < 8 {
+		r, _, err := in.ReadRune()
+		if err != nil {
+			break
+		}
+		b.WriteRune(r)
+		if b.Len() == 1 {
+			if r != '[' && r != 'O' {
+				break
+			}
+			continue
+		}
+		if r == '~' || unicode.IsLetter(r) {
+			break
+		}
+	}
+	return b.String()
+}
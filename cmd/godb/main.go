@@ -0,0 +1,183 @@
+// Command godb runs ad-hoc operations against a GoDB server.
+//
+// Usage:
+//
+//	godb [flags] command [arguments]
+//
+// The commands are:
+//
+//	repl                       read and run queries interactively
+//	tui                        browse databases, tables, and rows full screen
+//	query "SELECT ..."         run one query
+//	explain "SELECT ..."       print the plan of a query as a tree
+//	tables                     list the tables of the database
+//	schema [table...]          print CREATE TABLE statements
+//	export [-o file] table     write a table or query result as CSV or JSON lines
+//	import [-infer] table file load a CSV or JSON lines file into a table
+//	user create|delete|list|passwd|grant ...
+//
+// The server address and connection string come from the -addr and -conn
+// flags or the GODB_ADDR and GODB_CONN environment variables. With -profile, or
+// GODB_PROFILE set, they and the client settings come from that profile of the
+// config package's file instead, and -addr and -conn given on the command line
+// override it. Run "godb help" for the flags of each command.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+	"github.com/prakhar-5447/GoDB_SDK_GO/config"
+)
+
+// command is a subcommand of godb.
+type command struct {
+	usage string
+	run   func(ctx context.Context, client *godb.GoDBClient, args []string) error
+}
+
+var commands = map[string]command{
+	"repl":    {"repl [-history=false]", runRepl},
+	"tui":     {"tui [-page n]", runTUI},
+	"query":   {"query [-format table|csv|json] \"SELECT ...\"", runQuery},
+	"explain": {"explain [-stats=false] \"SELECT ...\"", runExplain},
+	"tables":  {"tables", runTables},
+	"schema":  {"schema [table...]", runSchema},
+	"export":  {"export [-where cond] [-format csv|json] [-delim c] [-gzip] [-o file] table", runExport},
+	"import":  {"import [-format csv|json] [-delim c] [-gzip] [-infer] [-batch n] table [file]", runImport},
+	"user":    {"user create|delete|list|passwd|grant ...", runUser},
+}
+
+// order lists the commands for usage.
+var order = []string{"repl", "tui", "query", "explain", "tables", "schema", "export", "import", "user"}
+
+func main() {
+	addr := flag.String("addr", envOr("GODB_ADDR", "localhost:50051"), "server address")
+	conn := flag.String("conn", os.Getenv("GODB_CONN"), "connection string, e.g. grpc://user:password/db")
+	timeout := flag.Duration("timeout", 0, "per-call timeout; 0 waits for each call")
+	profile := flag.String("profile", os.Getenv(config.EnvProfile), "profile in the config file ($GODB_CONFIG or ~/.godb/config.json)")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 || flag.Arg(0) == "help" {
+		usage()
+		if flag.NArg() == 0 {
+			os.Exit(2)
+		}
+		return
+	}
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "godb: unknown command %q\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+
+	set := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	var opts []godb.Option
+	if *profile != "" {
+		p, err := loadProfile(*profile)
+		if err != nil {
+			fatal(err)
+		}
+		if opts, err = p.Options(); err != nil {
+			fatal(err)
+		}
+		if !set["addr"] {
+			*addr = p.Address
+		}
+		if set["conn"] {
+			opts = append(opts, godb.WithConnectionString(*conn))
+		}
+	} else {
+		opts = append(opts, godb.WithConnectionString(*conn))
+	}
+	if *timeout > 0 {
+		opts = append(opts, godb.WithDefaultTimeout(*timeout))
+	}
+	client, err := godb.NewGoDBClient(*addr, opts...)
+	if err != nil {
+		fatal(err)
+	}
+	defer client.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := cmd.run(ctx, client, flag.Args()[1:]); err != nil {
+		var uerr usageError
+		if errors.As(err, &uerr) {
+			fmt.Fprintf(os.Stderr, "usage: godb %s\n", cmd.usage)
+			client.Close()
+			os.Exit(2)
+		}
+		client.Close()
+		fatal(err)
+	}
+}
+
+// loadProfile resolves the named profile of the config file.
+func loadProfile(name string) (*config.Profile, error) {
+	f, err := config.Load(config.DefaultPath())
+	if err != nil {
+		return nil, err
+	}
+	return f.Profile(name)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: godb [flags] command [arguments]")
+	fmt.Fprintln(os.Stderr, "\ncommands:")
+	for _, name := range order {
+		fmt.Fprintf(os.Stderr, "  godb %s\n", commands[name].usage)
+	}
+	fmt.Fprintln(os.Stderr, "\nflags:")
+	flag.PrintDefaults()
+}
+
+// usageError reports bad arguments to a command.
+type usageError struct{}
+
+func (usageError) Error() string { return "usage" }
+
+// newFlags returns the flag set of a command, which reports errors as a
+// usageError.
+func newFlags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {}
+	return fs
+}
+
+// parseFlags parses args, wanting between min and max positional arguments;
+// max < 0 means no limit.
+func parseFlags(fs *flag.FlagSet, args []string, min, max int) error {
+	if err := fs.Parse(args); err != nil {
+		return usageError{}
+	}
+	if fs.NArg() < min || (max >= 0 && fs.NArg() > max) {
+		return usageError{}
+	}
+	return nil
+}
+
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+// fatal prints err and exits. SDK errors already start with "godb:".
+func fatal(err error) {
+	msg := err.Error()
+	if !strings.HasPrefix(msg, "godb: ") {
+		msg = "godb: " + msg
+	}
+	fmt.Fprintln(os.Stderr, msg)
+	os.Exit(1)
+}
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+	"github.com/prakhar-5447/GoDB_SDK_GO/ast"
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+const shellHelp = `Enter a SELECT statement ending with ;, which may span lines, or one of:
+  \dt         list tables
+  \d table    describe a table
+  \format f   print results as table, csv, or json
+  \q          quit`
+
+// runRepl reads statements and prints their results. On a terminal lines can
+// be edited, and statements are kept in a history file per connection string.
+// Ctrl-C abandons the line being typed or stops the running statement; it
+// does not leave the REPL.
+func runRepl(ctx context.Context, client *godb.GoDBClient, args []string) error {
+	fs := newFlags("repl")
+	saveHistory := fs.Bool("history", true, "save statements to a history file for the connection string")
+	if err := parseFlags(fs, args, 0, 0); err != nil {
+		return err
+	}
+	// main cancels ctx on the first interrupt for good, so statements get
+	// their own contexts, each cancelled by an interrupt while it runs.
+	ctx = context.WithoutCancel(ctx)
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+	run := func(fn func(ctx context.Context) error) {
+		select {
+		case <-interrupts: // sent while at the prompt
+		default:
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			select {
+			case <-interrupts:
+				cancel()
+			case <-done:
+			}
+		}()
+		report(fn(ctx))
+	}
+	var in lineReader = newPlainReader(os.Stdin, os.Stdout)
+	if fd := int(os.Stdin.Fd()); isTerminal(fd) {
+		path := ""
+		if *saveHistory {
+			path = historyPath(client.ConnectionString())
+		}
+		in = newEditor(fd, os.Stdin, os.Stdout, path)
+	}
+	fmt.Println(`godb repl; type \? for help.`)
+	format := "table"
+	var stmt strings.Builder
+	for {
+		prompt := "godb> "
+		if stmt.Len() > 0 {
+			prompt = "   -> "
+		}
+		line, err := in.readLine(prompt)
+		if err == errInterrupted {
+			stmt.Reset()
+			continue
+		}
+		if err == io.EOF {
+			if stmt.Len() > 0 {
+				fmt.Println()
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line = strings.TrimSpace(line)
+		if stmt.Len() == 0 && strings.HasPrefix(line, `\`) {
+			in.addHistory(line)
+			fields := strings.Fields(line)
+			switch fields[0] {
+			case `\q`:
+				return nil
+			case `\?`:
+				fmt.Println(shellHelp)
+			case `\dt`:
+				run(func(ctx context.Context) error { return runTables(ctx, client, nil) })
+			case `\d`:
+				run(func(ctx context.Context) error { return runSchema(ctx, client, fields[1:]) })
+			case `\format`:
+				if len(fields) == 2 && validFormat(fields[1]) {
+					format = fields[1]
+				} else {
+					fmt.Println("formats are table, csv, and json")
+				}
+			default:
+				fmt.Printf("unknown command %s; type \\? for help\n", fields[0])
+			}
+			continue
+		}
+		if line == "" {
+			continue
+		}
+		stmt.WriteString(line)
+		stmt.WriteByte(' ')
+		if !strings.HasSuffix(line, ";") {
+			continue
+		}
+		text := strings.TrimSpace(stmt.String())
+		stmt.Reset()
+		in.addHistory(text)
+		run(func(ctx context.Context) error {
+			return query(ctx, client, strings.TrimSuffix(text, ";"), format, os.Stdout)
+		})
+	}
+}
+
+// report prints a shell command's error.
+func report(err error) {
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+}
+
+// runQuery runs one SELECT statement.
+func runQuery(ctx context.Context, client *godb.GoDBClient, args []string) error {
+	fs := newFlags("query")
+	format := fs.String("format", "table", "output format: table, csv, or json")
+	if err := parseFlags(fs, args, 1, 1); err != nil || !validFormat(*format) {
+		return usageError{}
+	}
+	return query(ctx, client, fs.Arg(0), *format, os.Stdout)
+}
+
+func validFormat(f string) bool {
+	return f == "table" || f == "csv" || f == "json"
+}
+
+// query parses and runs a SELECT statement, writing the rows to w.
+func query(ctx context.Context, client *godb.GoDBClient, text, format string, w io.Writer) error {
+	q, err := ast.Parse(text)
+	if err != nil {
+		return err
+	}
+	if q.Table == "" {
+		return fmt.Errorf("want a SELECT statement with a FROM clause")
+	}
+	qb := client.QueryFromAST(ctx, q)
+	switch format {
+	case "csv":
+		_, err = client.Export(ctx).Query(qb).To(w)
+		return err
+	case "json":
+		_, err = client.Export(ctx).Query(qb).Format(godb.JSONLines).To(w)
+		return err
+	}
+	resp, err := qb.Exec()
+	if err != nil {
+		return err
+	}
+	printRows(w, resp)
+	return nil
+}
+
+// printRows writes a result as an aligned table, showing NULL for missing
+// columns.
+func printRows(w io.Writer, resp *proto.QueryDataResponse) {
+	var columns []string
+	for _, col := range resp.Columns {
+		columns = append(columns, col.Name)
+	}
+	if len(columns) == 0 && len(resp.Rows) > 0 {
+		for col := range resp.Rows[0].Data {
+			columns = append(columns, col)
+		}
+		sort.Strings(columns)
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(columns, "\t"))
+	for _, row := range resp.Rows {
+		values := make([]string, len(columns))
+		for i, col := range columns {
+			v, ok := row.Data[col]
+			if !ok {
+				v = "NULL"
+			}
+			values[i] = v
+		}
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "(%d rows)\n", len(resp.Rows))
+}
+
+// runTables lists the tables of the database.
+func runTables(ctx context.Context, client *godb.GoDBClient, args []string) error {
+	if err := parseFlags(newFlags("tables"), args, 0, 0); err != nil {
+		return err
+	}
+	tables, err := client.ListTables(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range tables {
+		fmt.Println(t)
+	}
+	return nil
+}
+
+// runSchema prints a CREATE TABLE statement for each table, or for every table
+// of the database when none are named.
+func runSchema(ctx context.Context, client *godb.GoDBClient, args []string) error {
+	fs := newFlags("schema")
+	if err := parseFlags(fs, args, 0, -1); err != nil {
+		return err
+	}
+	tables := fs.Args()
+	if len(tables) == 0 {
+		var err error
+		if tables, err = client.ListTables(ctx); err != nil {
+			return err
+		}
+	}
+	for i, table := range tables {
+		desc, err := client.DescribeTable(ctx, table, client.ConnectionString())
+		if err != nil {
+			return err
+		}
+		if !desc.Exists {
+			return fmt.Errorf("no such table: %s", table)
+		}
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("CREATE TABLE %s (\n", table)
+		for j, col := range desc.Columns {
+			decl := "  " + col.Name + " " + col.Type
+			if !col.Nullable {
+				decl += " NOT NULL"
+			}
+			if j < len(desc.Columns)-1 {
+				decl += ","
+			}
+			fmt.Println(decl)
+		}
+		fmt.Println(");")
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build !(linux || darwin || dragonfly || freebsd || netbsd || openbsd)
+
+package main
+
+import "errors"
+
+// isTerminal reports false: line editing is only supported on Unix, so input
+// is read line by line as the terminal delivers it.
+func isTerminal(fd int) bool {
+	return false
+}
+
+func makeRaw(fd int) (func(), error) {
+	return nil, errors.New("raw terminal mode is not supported on this platform")
+}
+
+func terminalSize(fd int) (width, height int, err error) {
+	return 0, 0, errors.New("terminal size is not supported on this platform")
+}
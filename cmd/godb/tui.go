@@ -0,0 +1,509 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+	"github.com/prakhar-5447/GoDB_SDK_GO/ast"
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+const (
+	// tuiListWidth is the width of the list on the left of the screen.
+	tuiListWidth = 24
+	tuiHelp      = "↑↓ select  enter open  r rows  s schema  i indexes  n/p page  : query  ⌫ back  q quit"
+)
+
+// runTUI browses databases, tables, and rows full screen and runs ad-hoc
+// queries. It needs a terminal on both stdin and stdout.
+func runTUI(ctx context.Context, client *godb.GoDBClient, args []string) error {
+	fs := newFlags("tui")
+	pageSize := fs.Int("page", 0, "rows per page; 0 fits the screen")
+	if err := parseFlags(fs, args, 0, 0); err != nil {
+		return err
+	}
+	in, out := int(os.Stdin.Fd()), int(os.Stdout.Fd())
+	if !isTerminal(in) || !isTerminal(out) {
+		return errors.New("tui needs a terminal")
+	}
+	restore, err := makeRaw(in)
+	if err != nil {
+		return err
+	}
+	defer restore()
+	w := bufio.NewWriter(os.Stdout)
+	// Switch to the alternate screen and hide the cursor until we leave.
+	fmt.Fprint(w, "\x1b[?1049h\x1b[?25l")
+	defer func() {
+		fmt.Fprint(w, "\x1b[?25h\x1b[?1049l")
+		w.Flush()
+	}()
+
+	r := bufio.NewReader(os.Stdin)
+	t := &tui{
+		ctx:      ctx,
+		client:   client,
+		fd:       out,
+		in:       r,
+		out:      w,
+		prompt:   newEditor(in, r, w, ""),
+		pageSize: *pageSize,
+	}
+	t.showDatabases()
+	return t.loop()
+}
+
+// tuiView is what the right-hand pane shows.
+type tuiView int
+
+const (
+	viewNone tuiView = iota
+	viewRows
+	viewSchema
+	viewIndexes
+	viewQuery
+)
+
+// tui is the state of a runTUI session. The list on the left holds the
+// databases, or the tables of the open database; the pane on the right shows
+// the selected table or the last query's result.
+type tui struct {
+	ctx    context.Context
+	client *godb.GoDBClient
+	fd     int
+	in     *bufio.Reader
+	out    *bufio.Writer
+	prompt *editor
+
+	database string   // open database; empty while listing databases
+	items    []string // databases or tables
+	sel, top int      // selected item and first item shown
+
+	view     tuiView
+	table    string
+	pages    []*godb.Page // pages of table shown so far; the last is on screen
+	pageSize int          // 0 fits the screen
+	pane     []string     // lines of the right-hand pane
+	status   string
+}
+
+// loop reads keys and redraws until the user quits.
+func (t *tui) loop() error {
+	for {
+		t.draw()
+		key, err := t.readKey()
+		if err != nil {
+			return err
+		}
+		t.status = ""
+		switch key {
+		case "q", "\x03", "\x04": // q, Ctrl-C, Ctrl-D
+			return nil
+		case "up", "k":
+			t.move(-1)
+		case "down", "j":
+			t.move(1)
+		case "\r", "\n":
+			t.open()
+		case "\x7f", "\b", "esc", "h":
+			if t.database != "" {
+				t.showDatabases()
+			}
+		case "r":
+			t.showRows()
+		case "s":
+			t.showSchema()
+		case "i":
+			t.showIndexes()
+		case "n", "pgdown":
+			t.nextPage()
+		case "p", "pgup":
+			t.prevPage()
+		case ":", "/":
+			t.query()
+		case "\x0c": // Ctrl-L redraws
+		}
+	}
+}
+
+// readKey reads one key press, naming arrow and paging keys.
+func (t *tui) readKey() (string, error) {
+	r, _, err := t.in.ReadRune()
+	if err != nil {
+		return "", err
+	}
+	if r != 27 {
+		return string(r), nil
+	}
+	if t.in.Buffered() == 0 {
+		return "esc", nil
+	}
+	switch readEscape(t.in) {
+	case "[A", "OA":
+		return "up", nil
+	case "[B", "OB":
+		return "down", nil
+	case "[5~":
+		return "pgup", nil
+	case "[6~":
+		return "pgdown", nil
+	}
+	return "", nil
+}
+
+// callCtx returns the context for calls against the open database.
+func (t *tui) callCtx() context.Context {
+	if t.database == "" {
+		return t.ctx
+	}
+	return godb.WithConnection(t.ctx, t.client.Database(t.database).ConnectionString())
+}
+
+// showDatabases lists the user's databases. Users who may not list them see
+// only the database of the connection string.
+func (t *tui) showDatabases() {
+	prev := t.database
+	t.database, t.view, t.table, t.pages, t.pane = "", viewNone, "", nil, nil
+	dbs, err := t.client.ListDatabases(t.ctx)
+	if err != nil || len(dbs) == 0 {
+		t.openDatabase(t.client.DatabaseFromConnectionString(t.client.ConnectionString()).Name())
+		return
+	}
+	t.setItems(dbs, prev)
+}
+
+// openDatabase lists the tables of name.
+func (t *tui) openDatabase(name string) {
+	t.database, t.view, t.table, t.pages, t.pane = name, viewNone, "", nil, nil
+	tables, err := t.client.ListTables(t.callCtx())
+	if err != nil {
+		t.fail(err)
+	}
+	t.setItems(tables, "")
+	if len(tables) == 0 && err == nil {
+		t.status = "no tables"
+	}
+}
+
+// setItems replaces the list, selecting selected if present.
+func (t *tui) setItems(items []string, selected string) {
+	t.items, t.sel, t.top = items, 0, 0
+	for i, item := range items {
+		if item == selected {
+			t.sel = i
+		}
+	}
+}
+
+// move moves the selection by delta, showing the rows of a newly selected
+// table.
+func (t *tui) move(delta int) {
+	sel := t.sel + delta
+	if sel < 0 || sel >= len(t.items) {
+		return
+	}
+	t.sel = sel
+	if t.database != "" && t.view != viewQuery {
+		t.showRows()
+	}
+}
+
+// open opens the selected database or shows the selected table's rows.
+func (t *tui) open() {
+	if len(t.items) == 0 {
+		return
+	}
+	if t.database == "" {
+		t.openDatabase(t.items[t.sel])
+		return
+	}
+	t.showRows()
+}
+
+// selectedTable returns the table under the selection, or "".
+func (t *tui) selectedTable() string {
+	if t.database == "" || len(t.items) == 0 {
+		return ""
+	}
+	return t.items[t.sel]
+}
+
+// rowsPerPage returns the page size, fitting the screen unless set.
+func (t *tui) rowsPerPage() int {
+	if t.pageSize > 0 {
+		return t.pageSize
+	}
+	_, height := t.size()
+	// Title, column header, row count, and status lines.
+	if n := height - 4; n > 1 {
+		return n
+	}
+	return 1
+}
+
+// showRows shows the first page of the selected table.
+func (t *tui) showRows() {
+	table := t.selectedTable()
+	if table == "" {
+		return
+	}
+	t.view, t.table, t.pages = viewRows, table, nil
+	page, err := t.client.Table(table).Find(t.callCtx(), nil).Paginate(t.rowsPerPage())
+	if err != nil {
+		t.pane = nil
+		t.fail(err)
+		return
+	}
+	t.pages = []*godb.Page{page}
+	t.renderPage()
+}
+
+// nextPage shows the page after the current one.
+func (t *tui) nextPage() {
+	if t.view != viewRows || len(t.pages) == 0 {
+		return
+	}
+	next, err := t.pages[len(t.pages)-1].Next(t.callCtx())
+	if err != nil {
+		t.fail(err)
+		return
+	}
+	if next == nil {
+		t.status = "last page"
+		return
+	}
+	t.pages = append(t.pages, next)
+	t.renderPage()
+}
+
+// prevPage shows the page before the current one.
+func (t *tui) prevPage() {
+	if t.view != viewRows || len(t.pages) < 2 {
+		return
+	}
+	t.pages = t.pages[:len(t.pages)-1]
+	t.renderPage()
+}
+
+// renderPage fills the pane with the current page of rows.
+func (t *tui) renderPage() {
+	page := t.pages[len(t.pages)-1]
+	resp := &proto.QueryDataResponse{Columns: page.Columns}
+	for _, row := range page.Rows {
+		resp.Rows = append(resp.Rows, &proto.QueryRow{Data: row})
+	}
+	t.pane = renderRows(resp)
+	more := ""
+	if page.HasMore {
+		more = ", more follow"
+	}
+	t.pane[len(t.pane)-1] = fmt.Sprintf("page %d (%d rows%s)", len(t.pages), len(page.Rows), more)
+}
+
+// showSchema shows the columns of the selected table.
+func (t *tui) showSchema() {
+	table := t.selectedTable()
+	if table == "" {
+		return
+	}
+	t.view, t.table, t.pane = viewSchema, table, nil
+	desc, err := t.client.Table(table).Describe(t.callCtx())
+	if err != nil {
+		t.fail(err)
+		return
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "column\ttype\tnull\n")
+	for _, col := range desc.Columns {
+		null := "NOT NULL"
+		if col.Nullable {
+			null = ""
+		}
+		fmt.Fprintf(&b, "%s\t%s\t%s\n", col.Name, col.Type, null)
+	}
+	t.pane = tabulate(b.String())
+}
+
+// showIndexes shows the indexes of the selected table and how often the
+// server has used them.
+func (t *tui) showIndexes() {
+	table := t.selectedTable()
+	if table == "" {
+		return
+	}
+	t.view, t.table, t.pane = viewIndexes, table, nil
+	ctx := t.callCtx()
+	indexes, err := t.client.Table(table).Indexes(ctx)
+	if err != nil {
+		t.fail(err)
+		return
+	}
+	scans := make(map[string]int64)
+	if stats, err := t.client.Table(table).IndexStats(ctx); err == nil {
+		for _, s := range stats {
+			scans[s.IndexName] = s.Scans
+		}
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "index\tcolumns\tunique\tscans\n")
+	for _, idx := range indexes {
+		unique := ""
+		if idx.Unique {
+			unique = "yes"
+		}
+		fmt.Fprintf(&b, "%s\t%s\t%s\t%d\n", idx.IndexName, idx.Columns, unique, scans[idx.IndexName])
+	}
+	t.pane = tabulate(b.String())
+	if len(indexes) == 0 {
+		t.pane = []string{"no indexes"}
+	}
+}
+
+// query reads a SELECT statement on the bottom line and shows its result.
+func (t *tui) query() {
+	if t.database == "" {
+		t.status = "open a database first"
+		return
+	}
+	_, height := t.size()
+	fmt.Fprintf(t.out, "\x1b[%d;1H\x1b[K\x1b[?25h", height)
+	t.out.Flush()
+	text, err := t.prompt.readLine("query> ")
+	fmt.Fprint(t.out, "\x1b[?25l")
+	if err == errInterrupted {
+		return
+	}
+	if err != nil {
+		t.fail(err)
+		return
+	}
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+	t.prompt.addHistory(text)
+	q, err := ast.Parse(strings.TrimSuffix(strings.TrimSpace(text), ";"))
+	if err != nil {
+		t.fail(err)
+		return
+	}
+	if q.Table == "" {
+		t.status = "want a SELECT statement with a FROM clause"
+		return
+	}
+	ctx := t.callCtx()
+	resp, err := t.client.QueryFromAST(ctx, q).Exec()
+	if err != nil {
+		t.fail(err)
+		return
+	}
+	t.view, t.table, t.pages = viewQuery, q.Table, nil
+	t.pane = renderRows(resp)
+}
+
+// fail shows err on the status line.
+func (t *tui) fail(err error) {
+	t.status = "error: " + err.Error()
+}
+
+// size returns the terminal size, or 80x24 if it cannot be read.
+func (t *tui) size() (width, height int) {
+	width, height, err := terminalSize(t.fd)
+	if err != nil || width <= 0 || height <= 0 {
+		return 80, 24
+	}
+	return width, height
+}
+
+// draw renders the whole screen.
+func (t *tui) draw() {
+	width, height := t.size()
+	body := height - 2
+	if t.sel < t.top {
+		t.top = t.sel
+	}
+	if t.sel >= t.top+body {
+		t.top = t.sel - body + 1
+	}
+
+	fmt.Fprint(t.out, "\x1b[H\x1b[2J")
+	title := "godb tui — databases"
+	if t.database != "" {
+		title = "godb tui — " + t.database
+		if t.table != "" {
+			title += " / " + t.table
+		}
+	}
+	fmt.Fprintf(t.out, "\x1b[7m%s\x1b[0m\r\n", pad(title, width))
+
+	paneWidth := width - tuiListWidth - 3
+	for i := 0; i < body; i++ {
+		item := ""
+		if n := t.top + i; n < len(t.items) {
+			item = " " + t.items[n]
+			if n == t.sel {
+				item = ">" + t.items[n]
+			}
+		}
+		item = pad(item, tuiListWidth)
+		if n := t.top + i; n == t.sel && n < len(t.items) {
+			item = "\x1b[1m" + item + "\x1b[0m"
+		}
+		line := ""
+		if i < len(t.pane) {
+			line = truncate(t.pane[i], paneWidth)
+		}
+		fmt.Fprintf(t.out, "%s │ %s\r\n", item, line)
+	}
+
+	status := t.status
+	if status == "" {
+		status = tuiHelp
+	}
+	fmt.Fprintf(t.out, "\x1b[7m%s\x1b[0m", pad(status, width))
+	t.out.Flush()
+}
+
+// renderRows formats a result as table lines ending with the row count, as
+// the query command prints it.
+func renderRows(resp *proto.QueryDataResponse) []string {
+	var b bytes.Buffer
+	printRows(&b, resp)
+	return strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+}
+
+// tabulate aligns tab-separated lines.
+func tabulate(text string) []string {
+	var b bytes.Buffer
+	tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	io.WriteString(tw, text)
+	tw.Flush()
+	return strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+}
+
+// pad truncates or pads s with spaces to width characters.
+func pad(s string, width int) string {
+	s = truncate(s, width)
+	if n := width - len([]rune(s)); n > 0 {
+		s += strings.Repeat(" ", n)
+	}
+	return s
+}
+
+// truncate cuts s to at most width characters.
+func truncate(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if r := []rune(s); len(r) > width {
+		return string(r[:width])
+	}
+	return s
+}
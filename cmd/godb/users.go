@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+)
+
+// runUser manages users:
+//
+//	user create name          prints the new user's connection string
+//	user delete name
+//	user list
+//	user passwd name          prints the connection string with the new password
+//	user grant database name
+//
+// Passwords are read from standard input, one per line, so they stay out of
+// the shell history.
+func runUser(ctx context.Context, client *godb.GoDBClient, args []string) error {
+	if len(args) == 0 {
+		return usageError{}
+	}
+	sub, args := args[0], args[1:]
+	want := map[string]int{"create": 1, "delete": 1, "list": 0, "passwd": 1, "grant": 2}
+	n, ok := want[sub]
+	if !ok || len(args) != n {
+		return usageError{}
+	}
+	switch sub {
+	case "create":
+		password, err := readPassword("password for " + args[0])
+		if err != nil {
+			return err
+		}
+		_, connStr, err := client.CreateUser(ctx, args[0], password)
+		if err != nil {
+			return err
+		}
+		fmt.Println(connStr)
+	case "delete":
+		msg, err := client.DeleteUser(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Println(msg)
+	case "list":
+		users, err := client.ListUsers(ctx)
+		if err != nil {
+			return err
+		}
+		for _, u := range users {
+			fmt.Println(u)
+		}
+	case "passwd":
+		oldPassword, err := readPassword("current password")
+		if err != nil {
+			return err
+		}
+		newPassword, err := readPassword("new password")
+		if err != nil {
+			return err
+		}
+		_, connStr, err := client.ChangePassword(ctx, args[0], oldPassword, newPassword)
+		if err != nil {
+			return err
+		}
+		fmt.Println(connStr)
+	case "grant":
+		msg, err := client.GrantDatabaseAccess(ctx, args[0], args[1])
+		if err != nil {
+			return err
+		}
+		fmt.Println(msg)
+	}
+	return nil
+}
+
+// stdin is shared by the password prompts so buffered lines are not lost.
+var stdin = bufio.NewReader(os.Stdin)
+
+// readPassword prompts on standard error and reads a line from standard input,
+// without echoing it when standard input is a terminal.
+func readPassword(prompt string) (string, error) {
+	fmt.Fprintf(os.Stderr, "%s: ", prompt)
+	var (
+		password string
+		err      error
+	)
+	if fd := int(os.Stdin.Fd()); isTerminal(fd) {
+		password, err = readHidden(fd)
+		fmt.Fprintln(os.Stderr)
+	} else {
+		var line string
+		line, err = stdin.ReadString('\n')
+		if line != "" {
+			err = nil
+		}
+		password = strings.TrimRight(line, "\r\n")
+	}
+	if err != nil {
+		return "", fmt.Errorf("read %s: %w", prompt, err)
+	}
+	if password == "" {
+		return "", fmt.Errorf("%s is empty", prompt)
+	}
+	return password, nil
+}
+
+// readHidden reads a line from the terminal fd in raw mode, so nothing is
+// echoed. It handles backspace and Ctrl-U, and Ctrl-C abandons the line.
+func readHidden(fd int) (string, error) {
+	restore, err := makeRaw(fd)
+	if err != nil {
+		return "", err
+	}
+	defer restore()
+	var line []rune
+	for {
+		r, _, err := stdin.ReadRune()
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case r == '\r' || r == '\n':
+			return string(line), nil
+		case r == 3: // Ctrl-C
+			return "", errInterrupted
+		case r == 4 && len(line) == 0: // Ctrl-D
+			return "", io.EOF
+		case r == 0x7f || r == '\b':
+			if len(line) > 0 {
+				line = line[:len(line)-1]
+			}
+		case r == 0x15: // Ctrl-U
+			line = line[:0]
+		case r >= ' ':
+			line = append(line, r)
+		}
+	}
+}
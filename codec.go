@@ -0,0 +1,145 @@
+package godb
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+// typeCodec converts a registered Go type to and from its wire representation.
+type typeCodec struct {
+	encode func(interface{}) (string, error)
+	decode func(string) (interface{}, error)
+	// unquoted reports whether encoded values are rendered as bare literals in conditions.
+	unquoted bool
+}
+
+var (
+	codecsMu sync.RWMutex
+	codecs   = make(map[reflect.Type]typeCodec)
+)
+
+// RegisterType registers encode and decode functions for T. Registered types are
+// encoded when used as insert values, update values, or condition operands, and
+// decoded by Decode when reading query results.
+// Encoded values are quoted as strings inside conditions.
+func RegisterType[T any](encode func(T) (string, error), decode func(string) (T, error)) {
+	registerType(encode, decode, false)
+}
+
+func registerType[T any](encode func(T) (string, error), decode func(string) (T, error), unquoted bool) {
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+	codecs[reflect.TypeFor[T]()] = typeCodec{
+		encode: func(v interface{}) (string, error) {
+			return encode(v.(T))
+		},
+		decode: func(s string) (interface{}, error) {
+			return decode(s)
+		},
+		unquoted: unquoted,
+	}
+}
+
+// lookupCodec returns the codec registered for t, if any.
+func lookupCodec(t reflect.Type) (typeCodec, bool) {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+	c, ok := codecs[t]
+	return c, ok
+}
+
+// encodeValue converts a Go value into its wire string, consulting the type registry first.
+func encodeValue(value interface{}) (string, error) {
+	if value == nil {
+		return "", fmt.Errorf("cannot encode nil value")
+	}
+	if c, ok := lookupCodec(reflect.TypeOf(value)); ok {
+		s, err := c.encode(value)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode %T: %w", value, err)
+		}
+		return s, nil
+	}
+	return fmt.Sprintf("%v", value), nil
+}
+
+// Decode parses a wire string from a query result into dst, which must be a non-nil pointer.
+// Registered types are decoded with their codec; strings, booleans, integers, floats,
+// base64 byte slices, and RFC 3339 or SQL-style times are handled natively.
+func Decode(s string, dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("decode destination must be a non-nil pointer, got %T", dst)
+	}
+	return decodeInto(s, rv.Elem())
+}
+
+// decodeInto parses s into the settable value v.
+func decodeInto(s string, v reflect.Value) error {
+	if c, ok := lookupCodec(v.Type()); ok {
+		decoded, err := c.decode(s)
+		if err != nil {
+			return fmt.Errorf("failed to decode %q into %s: %w", s, v.Type(), err)
+		}
+		v.Set(reflect.ValueOf(decoded))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("failed to decode %q into %s: %w", s, v.Type(), err)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("failed to decode %q into %s: %w", s, v.Type(), err)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("failed to decode %q into %s: %w", s, v.Type(), err)
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("failed to decode %q into %s: %w", s, v.Type(), err)
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("unsupported decode destination %s", v.Type())
+		}
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return fmt.Errorf("failed to decode %q into %s: %w", s, v.Type(), err)
+		}
+		v.SetBytes(b)
+	case reflect.Struct:
+		if v.Type() != timeType {
+			return fmt.Errorf("unsupported decode destination %s", v.Type())
+		}
+		t, err := parseTime(s)
+		if err != nil {
+			return fmt.Errorf("failed to decode %q into %s: %w", s, v.Type(), err)
+		}
+		v.Set(reflect.ValueOf(t))
+	case reflect.Ptr:
+		elem := reflect.New(v.Type().Elem())
+		if err := decodeInto(s, elem.Elem()); err != nil {
+			return err
+		}
+		v.Set(elem)
+	default:
+		return fmt.Errorf("unsupported decode destination %s", v.Type())
+	}
+	return nil
+}
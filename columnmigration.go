@@ -0,0 +1,258 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// ColumnPhase is a step of an online column rename or retype.
+type ColumnPhase int32
+
+// Phases of a column migration, in the order a rollout moves through them.
+// Backfill the new column between PhaseDualWrite and PhaseReadNew, and drop
+// the old one once every deployment has reached PhaseCutover.
+const (
+	// PhaseDualWrite writes both columns and reads the old one.
+	PhaseDualWrite ColumnPhase = iota
+	// PhaseReadNew writes both columns and reads the new one, falling back to
+	// the old one where the new one is missing.
+	PhaseReadNew
+	// PhaseCutover writes only the new column and reads it as PhaseReadNew.
+	PhaseCutover
+)
+
+// ColumnMigration moves a column's values from Old to New in Table. Rows read
+// through the client carry both names whatever the phase, so code can switch
+// to New before the data does. Conditions are sent unchanged; filter on the
+// column being read in the current phase.
+type ColumnMigration struct {
+	Table string
+	Old   string
+	New   string
+	Phase ColumnPhase
+	// ToNew converts an old value for the new column, for retypes. Nil copies it.
+	ToNew func(old string) (string, error)
+	// ToOld converts a new value back for the old column. Nil copies it.
+	ToOld func(new string) (string, error)
+}
+
+// WithColumnMigration dual-writes and falls back on reads for m. Move a
+// running client to the next phase with SetColumnPhase.
+func WithColumnMigration(m ColumnMigration) Option {
+	return func(o *clientOptions) {
+		if o.columnMigrations == nil {
+			o.columnMigrations = make(columnMigrations)
+		}
+		cm := &columnMigration{ColumnMigration: m}
+		cm.phase.Store(int32(m.Phase))
+		o.columnMigrations[m.Table] = append(o.columnMigrations[m.Table], cm)
+	}
+}
+
+// SetColumnPhase moves the migration of column, the new column name, in table
+// to phase, e.g. when a feature flag flips.
+func (c *GoDBClient) SetColumnPhase(table, column string, phase ColumnPhase) error {
+	for _, cm := range c.columnMigrations[table] {
+		if cm.New == column {
+			cm.phase.Store(int32(phase))
+			return nil
+		}
+	}
+	return fmt.Errorf("no migration of column %s in table %s", column, table)
+}
+
+// columnMigration is a ColumnMigration with its current phase.
+type columnMigration struct {
+	ColumnMigration
+	phase atomic.Int32
+}
+
+// columnMigrations maps table names to their column migrations.
+type columnMigrations map[string][]*columnMigration
+
+// writeRecord returns rec with the migrated columns written as the phase
+// requires, or rec itself when it has neither column. A value given for the
+// new column wins over one for the old column.
+func (cm *columnMigration) writeRecord(rec map[string]string) (map[string]string, error) {
+	newVal, hasNew := rec[cm.New]
+	oldVal, hasOld := rec[cm.Old]
+	if !hasNew && !hasOld {
+		return rec, nil
+	}
+	out := copyRow(rec, 1)
+	if hasNew {
+		v, err := convertColumn(newVal, cm.ToOld)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %w", cm.Old, err)
+		}
+		out[cm.Old] = v
+	} else {
+		v, err := convertColumn(oldVal, cm.ToNew)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %w", cm.New, err)
+		}
+		out[cm.New] = v
+	}
+	if ColumnPhase(cm.phase.Load()) == PhaseCutover {
+		delete(out, cm.Old)
+	}
+	return out, nil
+}
+
+// readRow fills the migrated columns of a result row from each other and
+// returns the column it filled, if any.
+func (cm *columnMigration) readRow(row map[string]string) (string, error) {
+	newVal, hasNew := row[cm.New]
+	oldVal, hasOld := row[cm.Old]
+	switch {
+	case hasOld && (!hasNew || ColumnPhase(cm.phase.Load()) == PhaseDualWrite):
+		v, err := convertColumn(oldVal, cm.ToNew)
+		if err != nil {
+			return "", fmt.Errorf("column %s: %w", cm.New, err)
+		}
+		row[cm.New] = v
+		return cm.New, nil
+	case hasNew && !hasOld:
+		v, err := convertColumn(newVal, cm.ToOld)
+		if err != nil {
+			return "", fmt.Errorf("column %s: %w", cm.Old, err)
+		}
+		row[cm.Old] = v
+		return cm.Old, nil
+	}
+	return "", nil
+}
+
+// convertColumn applies convert to v, if set.
+func convertColumn(v string, convert func(string) (string, error)) (string, error) {
+	if convert == nil || v == Null {
+		return v, nil
+	}
+	return convert(v)
+}
+
+// writeRecord applies every migration of table to rec and typed, returning
+// copies when they change. Typed values are mirrored onto the other column of
+// a pair unless the values are converted, in which case the strings stand.
+func (cms columnMigrations) writeRecord(table string, rec map[string]string, typed map[string]*proto.Value) (map[string]string, map[string]*proto.Value, error) {
+	for _, cm := range cms[table] {
+		out, err := cm.writeRecord(rec)
+		if err != nil {
+			return nil, nil, err
+		}
+		rec = out
+		v := typed[cm.New]
+		if v == nil {
+			v = typed[cm.Old]
+		}
+		if v == nil {
+			continue
+		}
+		next := make(map[string]*proto.Value, len(typed)+1)
+		for col, tv := range typed {
+			next[col] = tv
+		}
+		_, hasOld := rec[cm.Old]
+		_, hasNew := rec[cm.New]
+		// A typed value without a string form is a NULL, which needs no conversion.
+		onlyTyped := !hasOld && !hasNew
+		cutover := ColumnPhase(cm.phase.Load()) == PhaseCutover
+		for _, col := range []string{cm.Old, cm.New} {
+			delete(next, col)
+			_, present := rec[col]
+			switch {
+			case onlyTyped && !(col == cm.Old && cutover):
+				next[col] = v
+			case present && cm.ToNew == nil && cm.ToOld == nil:
+				next[col] = v
+			}
+		}
+		typed = next
+	}
+	return rec, typed, nil
+}
+
+// selectColumns adds the old columns to an explicit select list naming the
+// new ones, so reads can fall back to them.
+func (cms columnMigrations) selectColumns(table, columns string) string {
+	if strings.TrimSpace(columns) == "" || strings.TrimSpace(columns) == "*" {
+		return columns
+	}
+	selected := make(map[string]bool)
+	for _, col := range strings.Split(columns, ",") {
+		selected[strings.TrimSpace(col)] = true
+	}
+	for _, cm := range cms[table] {
+		if selected[cm.New] && !selected[cm.Old] {
+			columns += ", " + cm.Old
+			selected[cm.Old] = true
+		}
+	}
+	return columns
+}
+
+// interceptor dual-writes migrated columns and fills them on reads.
+func (cms columnMigrations) interceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	tr, ok := req.(tableRequest)
+	if !ok || cms[tr.GetTableName()] == nil {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	table := tr.GetTableName()
+	var err error
+	switch r := req.(type) {
+	case *proto.InsertRecordRequest:
+		r = protobuf.Clone(r).(*proto.InsertRecordRequest)
+		r.Record, r.TypedRecord, err = cms.writeRecord(table, r.Record, r.TypedRecord)
+		req = r
+	case *proto.InsertMultipleRecordsRequest:
+		r = protobuf.Clone(r).(*proto.InsertMultipleRecordsRequest)
+		for _, rec := range r.Records {
+			if rec.Data, rec.TypedData, err = cms.writeRecord(table, rec.Data, rec.TypedData); err != nil {
+				break
+			}
+		}
+		req = r
+	case *proto.UpsertRecordRequest:
+		r = protobuf.Clone(r).(*proto.UpsertRecordRequest)
+		r.Record, r.TypedRecord, err = cms.writeRecord(table, r.Record, r.TypedRecord)
+		req = r
+	case *proto.UpdateRecordRequest:
+		r = protobuf.Clone(r).(*proto.UpdateRecordRequest)
+		r.Updates, r.TypedUpdates, err = cms.writeRecord(table, r.Updates, r.TypedUpdates)
+		req = r
+	case *proto.QueryDataRequest:
+		if columns := cms.selectColumns(table, r.Columns); columns != r.Columns {
+			r = protobuf.Clone(r).(*proto.QueryDataRequest)
+			r.Columns = columns
+			req = r
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+		return err
+	}
+	rows := changeImages(reply)
+	if resp, ok := reply.(*proto.QueryDataResponse); ok {
+		rows = resp.Rows
+	}
+	for _, row := range rows {
+		for _, cm := range cms[table] {
+			filled, err := cm.readRow(row.Data)
+			if err != nil {
+				return err
+			}
+			// The filled column's typed value, if any, belongs to the row as stored.
+			delete(row.Values, filled)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+package godb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Cond is a composable condition expression, e.g.
+//
+//	godb.And(godb.Eq("a", 1), godb.Or(godb.Gt("b", 2), godb.Lt("c", 3)))
+//
+// Conditions are rendered centrally when a builder executes, so every operand is
+// encoded and escaped the same way. Pass them to a builder's Where method.
+type Cond interface {
+	render() (string, error)
+}
+
+// rawCond is condition text that is used verbatim.
+type rawCond string
+
+func (c rawCond) render() (string, error) {
+	return string(c), nil
+}
+
+// compareCond compares a field with a value using a binary operator.
+type compareCond struct {
+	field    string
+	operator string
+	value    interface{}
+}
+
+// render formats "field op value". Comparing with Null or nil renders IS NULL
+// for = and IS NOT NULL for != or <>.
+func (c compareCond) render() (string, error) {
+	if isNull(c.value) {
+		switch c.operator {
+		case "=":
+			return nullCond{field: c.field}.render()
+		case "!=", "<>":
+			return nullCond{field: c.field, negate: true}.render()
+		}
+		return "", fmt.Errorf("cannot compare %s with NULL using %s", c.field, c.operator)
+	}
+	return formatCondition(c.field, c.operator, c.value)
+}
+
+// inCond tests membership of a field in a value list.
+type inCond struct {
+	field  string
+	values []interface{}
+	negate bool
+}
+
+// render formats "field IN (v1, v2, ...)", or NOT IN when negated.
+// An empty value list matches nothing for IN and everything for NOT IN.
+func (c inCond) render() (string, error) {
+	if len(c.values) == 0 {
+		if c.negate {
+			return "1 = 1", nil
+		}
+		return "1 = 0", nil
+	}
+	literals := make([]string, len(c.values))
+	for i, v := range c.values {
+		lit, err := formatLiteral(v)
+		if err != nil {
+			return "", err
+		}
+		literals[i] = lit
+	}
+	op := "IN"
+	if c.negate {
+		op = "NOT IN"
+	}
+	return fmt.Sprintf("%s %s (%s)", c.field, op, strings.Join(literals, ", ")), nil
+}
+
+// betweenCond tests that a field lies in an inclusive range.
+type betweenCond struct {
+	field  string
+	lo, hi interface{}
+}
+
+func (c betweenCond) render() (string, error) {
+	lo, err := formatLiteral(c.lo)
+	if err != nil {
+		return "", err
+	}
+	hi, err := formatLiteral(c.hi)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s BETWEEN %s AND %s", c.field, lo, hi), nil
+}
+
+// nullCond tests a field for NULL.
+type nullCond struct {
+	field  string
+	negate bool
+}
+
+func (c nullCond) render() (string, error) {
+	if c.negate {
+		return c.field + " IS NOT NULL", nil
+	}
+	return c.field + " IS NULL", nil
+}
+
+// andCond joins conditions with AND.
+type andCond []Cond
+
+func (c andCond) render() (string, error) {
+	parts, err := renderAll(c)
+	if err != nil {
+		return "", err
+	}
+	if len(parts) == 1 {
+		return parts[0], nil
+	}
+	for i, p := range parts {
+		parts[i] = groupOr(p)
+	}
+	return strings.Join(parts, " AND "), nil
+}
+
+// orCond joins conditions with OR.
+type orCond []Cond
+
+func (c orCond) render() (string, error) {
+	parts, err := renderAll(c)
+	if err != nil {
+		return "", err
+	}
+	if len(parts) == 1 {
+		return parts[0], nil
+	}
+	for i, p := range parts {
+		parts[i] = "(" + p + ")"
+	}
+	return strings.Join(parts, " OR "), nil
+}
+
+// notCond negates a condition.
+type notCond struct {
+	cond Cond
+}
+
+func (c notCond) render() (string, error) {
+	inner, err := renderCond(c.cond)
+	if err != nil || inner == "" {
+		return "", err
+	}
+	return "NOT (" + inner + ")", nil
+}
+
+// renderCond renders c, treating nil as no condition.
+func renderCond(c Cond) (string, error) {
+	if c == nil {
+		return "", nil
+	}
+	return c.render()
+}
+
+// renderAll renders each condition, dropping empty ones.
+func renderAll(conds []Cond) ([]string, error) {
+	parts := make([]string, 0, len(conds))
+	for _, c := range conds {
+		s, err := renderCond(c)
+		if err != nil {
+			return nil, err
+		}
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return parts, nil
+}
+
+// rawConds converts condition strings into conditions used verbatim.
+func rawConds(conds []string) []Cond {
+	out := make([]Cond, len(conds))
+	for i, c := range conds {
+		out[i] = rawCond(c)
+	}
+	return out
+}
+
+// Eq matches rows where field equals value.
+func Eq(field string, value interface{}) Cond {
+	return compareCond{field, "=", value}
+}
+
+// Ne matches rows where field differs from value.
+func Ne(field string, value interface{}) Cond {
+	return compareCond{field, "!=", value}
+}
+
+// Gt matches rows where field is greater than value.
+func Gt(field string, value interface{}) Cond {
+	return compareCond{field, ">", value}
+}
+
+// Gte matches rows where field is greater than or equal to value.
+func Gte(field string, value interface{}) Cond {
+	return compareCond{field, ">=", value}
+}
+
+// Lt matches rows where field is less than value.
+func Lt(field string, value interface{}) Cond {
+	return compareCond{field, "<", value}
+}
+
+// Lte matches rows where field is less than or equal to value.
+func Lte(field string, value interface{}) Cond {
+	return compareCond{field, "<=", value}
+}
+
+// Like matches rows where field matches the LIKE pattern.
+func Like(field, pattern string) Cond {
+	return compareCond{field, "LIKE", pattern}
+}
+
+// In matches rows where field is one of values.
+func In(field string, values ...interface{}) Cond {
+	return inCond{field: field, values: values}
+}
+
+// NotIn matches rows where field is none of values.
+func NotIn(field string, values ...interface{}) Cond {
+	return inCond{field: field, values: values, negate: true}
+}
+
+// Between matches rows where field lies between lo and hi inclusive.
+func Between(field string, lo, hi interface{}) Cond {
+	return betweenCond{field, lo, hi}
+}
+
+// IsNull matches rows where field is NULL.
+func IsNull(field string) Cond {
+	return nullCond{field: field}
+}
+
+// IsNotNull matches rows where field is not NULL.
+func IsNotNull(field string) Cond {
+	return nullCond{field: field, negate: true}
+}
+
+// And matches rows satisfying every condition. Empty conditions are ignored.
+func And(conds ...Cond) Cond {
+	return andCond(conds)
+}
+
+// Or matches rows satisfying at least one condition. Empty conditions are ignored.
+func Or(conds ...Cond) Cond {
+	return orCond(conds)
+}
+
+// Not matches rows that do not satisfy cond.
+func Not(cond Cond) Cond {
+	return notCond{cond}
+}
@@ -0,0 +1,114 @@
+package godb
+
+import (
+	"strings"
+)
+
+// Or adds a group of conditions joined with OR, e.g. Or("a = 1", "b > 2")
+// produces ((a = 1) OR (b > 2)) ANDed with the existing conditions.
+func (qb *QueryBuilder) Or(conds ...string) *QueryBuilder {
+	if len(conds) > 0 {
+		qb.Where(Or(rawConds(conds)...))
+	}
+	return qb
+}
+
+// Not adds the negation of cond.
+func (qb *QueryBuilder) Not(cond string) *QueryBuilder {
+	return qb.Where(Not(rawCond(cond)))
+}
+
+// In adds a membership condition (e.g., field IN (1, 2, 3)).
+func (qb *QueryBuilder) In(field string, values ...interface{}) *QueryBuilder {
+	return qb.Where(In(field, values...))
+}
+
+// NotIn adds a negated membership condition (e.g., field NOT IN (1, 2, 3)).
+func (qb *QueryBuilder) NotIn(field string, values ...interface{}) *QueryBuilder {
+	return qb.Where(NotIn(field, values...))
+}
+
+// Between adds an inclusive range condition (e.g., field BETWEEN lo AND hi).
+func (qb *QueryBuilder) Between(field string, lo, hi interface{}) *QueryBuilder {
+	return qb.Where(Between(field, lo, hi))
+}
+
+// Like adds a pattern match condition (e.g., field LIKE 'abc%').
+func (qb *QueryBuilder) Like(field, pattern string) *QueryBuilder {
+	return qb.Where(Like(field, pattern))
+}
+
+// IsNull adds a condition matching rows where field is NULL.
+func (qb *QueryBuilder) IsNull(field string) *QueryBuilder {
+	return qb.Where(IsNull(field))
+}
+
+// IsNotNull adds a condition matching rows where field is not NULL.
+func (qb *QueryBuilder) IsNotNull(field string) *QueryBuilder {
+	return qb.Where(IsNotNull(field))
+}
+
+// Or adds a group of conditions joined with OR.
+func (urb *UpdateRecordBuilder) Or(conds ...string) *UpdateRecordBuilder {
+	if len(conds) > 0 {
+		urb.Where(Or(rawConds(conds)...))
+	}
+	return urb
+}
+
+// Not adds the negation of cond.
+func (urb *UpdateRecordBuilder) Not(cond string) *UpdateRecordBuilder {
+	return urb.Where(Not(rawCond(cond)))
+}
+
+// In adds a membership condition.
+func (urb *UpdateRecordBuilder) In(field string, values ...interface{}) *UpdateRecordBuilder {
+	return urb.Where(In(field, values...))
+}
+
+// NotIn adds a negated membership condition.
+func (urb *UpdateRecordBuilder) NotIn(field string, values ...interface{}) *UpdateRecordBuilder {
+	return urb.Where(NotIn(field, values...))
+}
+
+// Between adds an inclusive range condition.
+func (urb *UpdateRecordBuilder) Between(field string, lo, hi interface{}) *UpdateRecordBuilder {
+	return urb.Where(Between(field, lo, hi))
+}
+
+// Like adds a pattern match condition.
+func (urb *UpdateRecordBuilder) Like(field, pattern string) *UpdateRecordBuilder {
+	return urb.Where(Like(field, pattern))
+}
+
+// IsNull adds a condition matching rows where field is NULL.
+func (urb *UpdateRecordBuilder) IsNull(field string) *UpdateRecordBuilder {
+	return urb.Where(IsNull(field))
+}
+
+// IsNotNull adds a condition matching rows where field is not NULL.
+func (urb *UpdateRecordBuilder) IsNotNull(field string) *UpdateRecordBuilder {
+	return urb.Where(IsNotNull(field))
+}
+
+// groupOr wraps cond in parentheses when it has a top-level OR, so that ANDing
+// it with further conditions keeps the intended precedence.
+func groupOr(cond string) string {
+	depth := 0
+	inQuote := false
+	upper := strings.ToUpper(cond)
+	for i := 0; i < len(upper); i++ {
+		switch c := upper[i]; {
+		case c == '\'':
+			inQuote = !inQuote
+		case inQuote:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case depth == 0 && strings.HasPrefix(upper[i:], " OR "):
+			return "(" + cond + ")"
+		}
+	}
+	return cond
+}
@@ -0,0 +1,280 @@
+// Package config loads named GoDB client profiles, such as dev, staging, and
+// prod, from a single JSON file so one binary can target different
+// deployments:
+//
+//	{
+//	  "default": "dev",
+//	  "profiles": {
+//	    "base":    {"timeout": "5s", "retries": 3},
+//	    "dev":     {"extends": "base", "address": "localhost:50051",
+//	                "connection_string": "godb://dev:dev/app"},
+//	    "prod":    {"extends": "base", "address": "godb.internal:50051",
+//	                "connection_string": "${GODB_PROD_CONNECTION_STRING}",
+//	                "preset": "interactive"}
+//	  }
+//	}
+//
+// A profile inherits every field it does not set from the profile named by
+// extends. ${VAR} references in the address and connection string are
+// expanded from the environment, and GODB_ADDRESS, GODB_CONNECTION_STRING,
+// and GODB_TIMEOUT override the resolved profile.
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+)
+
+// Environment variables read by this package.
+const (
+	// EnvConfig names the config file used by NewClientFromProfile.
+	EnvConfig = "GODB_CONFIG"
+	// EnvProfile selects the profile when none is named.
+	EnvProfile = "GODB_PROFILE"
+
+	envAddress          = "GODB_ADDRESS"
+	envConnectionString = "GODB_CONNECTION_STRING"
+	envTimeout          = "GODB_TIMEOUT"
+)
+
+// Presets accepted in a profile's preset field.
+var presets = map[string]func() godb.Option{
+	"low-latency": godb.ProfileLowLatency,
+	"bulk-ingest": godb.ProfileBulkIngest,
+	"interactive": godb.ProfileInteractiveApp,
+}
+
+// Duration is a time.Duration written as a string such as "5s" in JSON.
+type Duration time.Duration
+
+// UnmarshalJSON parses a duration string.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration must be a string like \"5s\": %w", err)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// MarshalJSON renders the duration as a string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// Profile is one resolved deployment configuration.
+type Profile struct {
+	Name                 string   `json:"-"`
+	Extends              string   `json:"extends,omitempty"`
+	Address              string   `json:"address"`
+	ConnectionString     string   `json:"connection_string,omitempty"`
+	Preset               string   `json:"preset,omitempty"` // low-latency, bulk-ingest or interactive
+	Timeout              Duration `json:"timeout,omitempty"`
+	Retries              int      `json:"retries,omitempty"`
+	RetryDelay           Duration `json:"retry_delay,omitempty"`
+	Compression          bool     `json:"compression,omitempty"`
+	InsertBatchSize      int      `json:"insert_batch_size,omitempty"`
+	MaxConcurrentStreams int      `json:"max_concurrent_streams,omitempty"`
+}
+
+// File is a parsed config file.
+type File struct {
+	Default  string                     `json:"default,omitempty"`
+	Profiles map[string]json.RawMessage `json:"profiles"`
+}
+
+// DefaultPath returns the config file used by NewClientFromProfile: $GODB_CONFIG
+// if set, otherwise ~/.godb/config.json.
+func DefaultPath() string {
+	if p := os.Getenv(EnvConfig); p != "" {
+		return p
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(".godb", "config.json")
+	}
+	return filepath.Join(home, ".godb", "config.json")
+}
+
+// Load reads and parses the config file at path.
+func Load(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return f, nil
+}
+
+// Parse parses a config file.
+func Parse(data []byte) (*File, error) {
+	var f File
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	if len(f.Profiles) == 0 {
+		return nil, fmt.Errorf("no profiles defined")
+	}
+	return &f, nil
+}
+
+// Profile resolves the named profile: its inherited fields, ${VAR} references,
+// and environment overrides. An empty name selects $GODB_PROFILE, then the
+// file's default.
+func (f *File) Profile(name string) (*Profile, error) {
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+	if name == "" {
+		name = f.Default
+	}
+	if name == "" {
+		return nil, fmt.Errorf("no profile named and no default set")
+	}
+	fields, err := f.resolve(name, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+	merged, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	p := &Profile{}
+	if err := json.Unmarshal(merged, p); err != nil {
+		return nil, fmt.Errorf("profile %s: %w", name, err)
+	}
+	p.Address = os.ExpandEnv(p.Address)
+	p.ConnectionString = os.ExpandEnv(p.ConnectionString)
+	p.Name = name
+	p.Extends = ""
+	if err := p.applyEnv(); err != nil {
+		return nil, fmt.Errorf("profile %s: %w", name, err)
+	}
+	if p.Address == "" {
+		return nil, fmt.Errorf("profile %s has no address", name)
+	}
+	return p, nil
+}
+
+// resolve returns the raw fields of name merged over those of its ancestors.
+func (f *File) resolve(name string, seen map[string]bool) (map[string]json.RawMessage, error) {
+	if seen[name] {
+		return nil, fmt.Errorf("profile %s is part of an extends cycle", name)
+	}
+	seen[name] = true
+	raw, ok := f.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown profile %q", name)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("profile %s: %w", name, err)
+	}
+	parentRaw, ok := fields["extends"]
+	if !ok {
+		return fields, nil
+	}
+	var parent string
+	if err := json.Unmarshal(parentRaw, &parent); err != nil {
+		return nil, fmt.Errorf("profile %s: extends must be a string", name)
+	}
+	merged, err := f.resolve(parent, seen)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return merged, nil
+}
+
+// applyEnv applies the GODB_* environment overrides.
+func (p *Profile) applyEnv() error {
+	if v := os.Getenv(envAddress); v != "" {
+		p.Address = v
+	}
+	if v := os.Getenv(envConnectionString); v != "" {
+		p.ConnectionString = v
+	}
+	if v := os.Getenv(envTimeout); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("%s: %w", envTimeout, err)
+		}
+		p.Timeout = Duration(d)
+	}
+	return nil
+}
+
+// Options converts the profile into client options. The preset, if any, comes
+// first so the profile's explicit settings override it.
+func (p *Profile) Options() ([]godb.Option, error) {
+	var opts []godb.Option
+	if p.Preset != "" {
+		preset, ok := presets[p.Preset]
+		if !ok {
+			return nil, fmt.Errorf("profile %s: unknown preset %q", p.Name, p.Preset)
+		}
+		opts = append(opts, preset())
+	}
+	if p.ConnectionString != "" {
+		opts = append(opts, godb.WithConnectionString(p.ConnectionString))
+	}
+	if p.Timeout > 0 {
+		opts = append(opts, godb.WithDefaultTimeout(time.Duration(p.Timeout)))
+	}
+	if p.Retries > 0 {
+		delay := time.Duration(p.RetryDelay)
+		if delay <= 0 {
+			delay = 100 * time.Millisecond
+		}
+		opts = append(opts, godb.WithRetry(p.Retries, delay))
+	}
+	if p.Compression {
+		opts = append(opts, godb.WithCompression())
+	}
+	if p.InsertBatchSize > 0 {
+		opts = append(opts, godb.WithInsertBatchSize(p.InsertBatchSize))
+	}
+	if p.MaxConcurrentStreams > 0 {
+		opts = append(opts, godb.WithMaxConcurrentStreams(p.MaxConcurrentStreams))
+	}
+	return opts, nil
+}
+
+// NewClient connects using the named profile. opts are applied after the
+// profile's own options.
+func (f *File) NewClient(name string, opts ...godb.Option) (*godb.GoDBClient, error) {
+	p, err := f.Profile(name)
+	if err != nil {
+		return nil, err
+	}
+	profileOpts, err := p.Options()
+	if err != nil {
+		return nil, err
+	}
+	return godb.NewGoDBClient(p.Address, append(profileOpts, opts...)...)
+}
+
+// NewClientFromProfile loads the config file at DefaultPath and connects using
+// the named profile; an empty name selects $GODB_PROFILE, then the file's
+// default.
+func NewClientFromProfile(name string, opts ...godb.Option) (*godb.GoDBClient, error) {
+	f, err := Load(DefaultPath())
+	if err != nil {
+		return nil, err
+	}
+	return f.NewClient(name, opts...)
+}
@@ -0,0 +1,222 @@
+package godb
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/connectivity"
+)
+
+// State is the state of the client's connection to the GoDB server.
+type State int
+
+const (
+	// StateIdle means the client has no active connection and will connect on the next call.
+	StateIdle State = iota
+	// StateConnecting means the client is establishing a connection.
+	StateConnecting
+	// StateReady means the connection is up.
+	StateReady
+	// StateTransientFailure means the connection failed and the client is backing off before retrying.
+	StateTransientFailure
+	// StateShutdown means the client was closed or gave up reconnecting.
+	StateShutdown
+)
+
+// String returns the name of the state.
+func (s State) String() string {
+	switch s {
+	case StateIdle:
+		return "IDLE"
+	case StateConnecting:
+		return "CONNECTING"
+	case StateReady:
+		return "READY"
+	case StateTransientFailure:
+		return "TRANSIENT_FAILURE"
+	case StateShutdown:
+		return "SHUTDOWN"
+	}
+	return "UNKNOWN"
+}
+
+// stateFromConnectivity converts a gRPC connectivity state.
+func stateFromConnectivity(s connectivity.State) State {
+	switch s {
+	case connectivity.Connecting:
+		return StateConnecting
+	case connectivity.Ready:
+		return StateReady
+	case connectivity.TransientFailure:
+		return StateTransientFailure
+	case connectivity.Shutdown:
+		return StateShutdown
+	}
+	return StateIdle
+}
+
+// ReconnectPolicy controls how the client reconnects after losing the server.
+// Zero fields fall back to gRPC's defaults.
+type ReconnectPolicy struct {
+	// BaseDelay is the backoff after the first failure.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff between attempts.
+	MaxDelay time.Duration
+	// Multiplier scales the backoff after each failed attempt.
+	Multiplier float64
+	// MaxAttempts is the number of consecutive failed attempts after which the
+	// client gives up and shuts down. Zero retries forever. A limit replaces any
+	// custom dialer set with WithDialOptions.
+	MaxAttempts int
+}
+
+// WithReconnect sets the client's reconnect backoff and attempt limit. Calls
+// made while the server is unreachable fail, and succeed again once it returns.
+func WithReconnect(p ReconnectPolicy) Option {
+	return func(o *clientOptions) {
+		cfg := backoff.DefaultConfig
+		if p.BaseDelay > 0 {
+			cfg.BaseDelay = p.BaseDelay
+		}
+		if p.MaxDelay > 0 {
+			cfg.MaxDelay = p.MaxDelay
+		}
+		if p.Multiplier > 0 {
+			cfg.Multiplier = p.Multiplier
+		}
+		o.dialOptions = append(o.dialOptions, grpc.WithConnectParams(grpc.ConnectParams{Backoff: cfg}))
+		o.maxReconnectAttempts = p.MaxAttempts
+	}
+}
+
+// OnStateChange registers fn to be called, from a background goroutine, each
+// time the connection changes state.
+func (c *GoDBClient) OnStateChange(fn func(State)) {
+	c.watcher.subscribe(fn)
+}
+
+// State returns the current connection state. Clients without a connection of
+// their own, such as those from NewGoDBClientFromService, are always ready.
+func (c *GoDBClient) State() State {
+	return c.watcher.state()
+}
+
+// stateWatcher follows the connection's state, notifies callbacks, and counts
+// failed connection attempts against the reconnect limit.
+type stateWatcher struct {
+	maxAttempts int
+	metrics     *MetricsCollector
+
+	mu        sync.Mutex
+	conn      *grpc.ClientConn
+	callbacks []func(State)
+	failures  int
+	gaveUp    bool
+
+	done chan struct{}
+}
+
+// newStateWatcher returns a watcher allowing maxAttempts consecutive failed
+// connection attempts, or unlimited attempts when maxAttempts is zero.
+func newStateWatcher(maxAttempts int) *stateWatcher {
+	w := &stateWatcher{
+		maxAttempts: maxAttempts,
+		done:        make(chan struct{}),
+	}
+	w.metrics = newMetricsCollector(w.state)
+	return w
+}
+
+// state returns the current connection state.
+func (w *stateWatcher) state() State {
+	w.mu.Lock()
+	conn := w.conn
+	w.mu.Unlock()
+	if conn == nil {
+		return StateReady
+	}
+	return stateFromConnectivity(conn.GetState())
+}
+
+// dial opens a TCP connection to the server, counting consecutive failures.
+// Once the limit is reached the client is shut down.
+func (w *stateWatcher) dial(ctx context.Context, addr string) (net.Conn, error) {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err == nil {
+		w.failures = 0
+		return conn, nil
+	}
+	w.failures++
+	if w.failures >= w.maxAttempts && !w.gaveUp && w.conn != nil {
+		w.gaveUp = true
+		go w.conn.Close()
+	}
+	return nil, err
+}
+
+// start begins watching conn.
+func (w *stateWatcher) start(conn *grpc.ClientConn) {
+	w.mu.Lock()
+	w.conn = conn
+	w.mu.Unlock()
+	go w.run(conn)
+}
+
+// run delivers each state transition until the connection shuts down.
+func (w *stateWatcher) run(conn *grpc.ClientConn) {
+	defer close(w.done)
+	state := conn.GetState()
+	if state == connectivity.Shutdown {
+		// Closed before the watcher started; there is no change to wait for.
+		return
+	}
+	for conn.WaitForStateChange(context.Background(), state) {
+		state = conn.GetState()
+		w.notify(stateFromConnectivity(state))
+		switch state {
+		case connectivity.TransientFailure:
+			w.metrics.connectionFailed()
+		case connectivity.Shutdown:
+			return
+		}
+	}
+}
+
+// subscribe registers fn to be called with each state change.
+func (w *stateWatcher) subscribe(fn func(State)) {
+	w.mu.Lock()
+	w.callbacks = append(w.callbacks, fn)
+	w.mu.Unlock()
+}
+
+// notify calls the registered callbacks with s.
+func (w *stateWatcher) notify(s State) {
+	w.mu.Lock()
+	callbacks := append([]func(State){}, w.callbacks...)
+	w.mu.Unlock()
+	for _, fn := range callbacks {
+		fn(s)
+	}
+}
+
+// close shuts the connection down and waits for the final notification. Closing
+// a connection the watcher already gave up on is not an error.
+func (w *stateWatcher) close() error {
+	if w.conn == nil {
+		return nil
+	}
+	err := w.conn.Close()
+	<-w.done
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.gaveUp {
+		return nil
+	}
+	return err
+}
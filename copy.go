@@ -0,0 +1,64 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// CopyTable creates table dst with the columns, constraints, and indexes of
+// src, and with its rows when withData is set, in the client's database. It
+// returns the number of rows copied. The copy runs on the server, so rows do
+// not travel through the client.
+func (c *GoDBClient) CopyTable(ctx context.Context, src, dst string, withData bool) (int64, error) {
+	return c.copyTable(ctx, c.connectionStringFor(ctx), src, dst, withData)
+}
+
+// CopyTable creates table dst from src in the database, as GoDBClient.CopyTable.
+func (db *Database) CopyTable(ctx context.Context, src, dst string, withData bool) (int64, error) {
+	return db.client.copyTable(ctx, db.connectionString, src, dst, withData)
+}
+
+func (c *GoDBClient) copyTable(ctx context.Context, connStr, src, dst string, withData bool) (_ int64, err error) {
+	defer wrapOpError(&err, "CopyTable", dst, proto.DatabaseService_CopyTable_FullMethodName, time.Now())
+	if src == "" || dst == "" {
+		return 0, fmt.Errorf("source and destination tables are required")
+	}
+	if src == dst {
+		return 0, fmt.Errorf("cannot copy table %s onto itself", src)
+	}
+	resp, err := c.client.CopyTable(ctx, &proto.CopyTableRequest{
+		ConnectionString: connStr,
+		SourceTable:      src,
+		TableName:        dst,
+		WithData:         withData,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.RowsCopied, nil
+}
+
+// CloneDatabase creates database newName with the schema and rows of the
+// database srcConnStr names, owned by the same user, and returns a handle to
+// it. The copy runs on the server.
+func (c *GoDBClient) CloneDatabase(ctx context.Context, srcConnStr, newName string) (_ *Database, err error) {
+	defer wrapOpError(&err, "CloneDatabase", "", proto.DatabaseService_CloneDatabase_FullMethodName, time.Now())
+	if newName == "" {
+		return nil, fmt.Errorf("new database name is required")
+	}
+	resp, err := c.client.CloneDatabase(ctx, &proto.CloneDatabaseRequest{
+		ConnectionString: srcConnStr,
+		NewDatabase:      newName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	connStr := resp.ConnectionString
+	if connStr == "" {
+		connStr = withDatabaseName(srcConnStr, newName)
+	}
+	return c.DatabaseFromConnectionString(connStr), nil
+}
@@ -0,0 +1,247 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// ColumnType is the declared type of a column.
+type ColumnType string
+
+// Column types understood by the server.
+const (
+	Int     ColumnType = "INTEGER"
+	Bool    ColumnType = "BOOLEAN"
+	Real    ColumnType = "REAL"
+	Numeric ColumnType = "NUMERIC"
+	Text    ColumnType = "TEXT"
+	Blob    ColumnType = "BLOB"
+	JSON    ColumnType = "JSON"
+)
+
+// ColumnDef describes one column of a new table.
+type ColumnDef struct {
+	Name       string
+	Type       ColumnType
+	PrimaryKey bool
+	NotNull    bool
+	Unique     bool
+	Default    interface{} // nil for no default
+	AutoID     proto.IdGeneration
+
+	// RefTable and RefColumn, when set, make the column a foreign key.
+	RefTable  string
+	RefColumn string
+	OnDelete  proto.ReferentialAction
+}
+
+// ColumnOption sets a constraint on a ColumnDef.
+type ColumnOption func(*ColumnDef)
+
+// PrimaryKey marks the column as the primary key.
+func PrimaryKey() ColumnOption {
+	return func(d *ColumnDef) { d.PrimaryKey = true }
+}
+
+// NotNull rejects NULL values in the column.
+func NotNull() ColumnOption {
+	return func(d *ColumnDef) { d.NotNull = true }
+}
+
+// Unique rejects duplicate values in the column.
+func Unique() ColumnOption {
+	return func(d *ColumnDef) { d.Unique = true }
+}
+
+// Default sets the value used when an insert omits the column. It is encoded
+// like a condition value, so registered types are supported.
+func Default(value interface{}) ColumnOption {
+	return func(d *ColumnDef) { d.Default = value }
+}
+
+// Ways the server can generate a column's value on insert.
+const (
+	AutoIDSequence = proto.IdGeneration_ID_GENERATION_SEQUENCE
+	AutoIDUUID     = proto.IdGeneration_ID_GENERATION_UUID
+)
+
+// AutoID makes the server generate the column's value when an insert omits it:
+// the next value of a sequence for Int columns, or a UUID for Text columns.
+// The generated value is reported in Result.InsertedIDs and by ExecID. Tag the
+// model field omitempty so inserts leave it out.
+func AutoID(kind proto.IdGeneration) ColumnOption {
+	return func(d *ColumnDef) { d.AutoID = kind }
+}
+
+// Column returns a ColumnDef with the given options applied.
+func Column(name string, colType ColumnType, opts ...ColumnOption) ColumnDef {
+	def := ColumnDef{Name: name, Type: colType}
+	for _, opt := range opts {
+		opt(&def)
+	}
+	return def
+}
+
+// CreateTableBuilder provides a fluent interface for creating a table from
+// structured column definitions.
+type CreateTableBuilder struct {
+	client           *GoDBClient
+	ctx              context.Context
+	connectionString string
+	tableName        string
+	columns          []ColumnDef
+	timeout          time.Duration
+}
+
+// NewTable returns a CreateTableBuilder using the client's stored connection string.
+func (client *GoDBClient) NewTable(ctx context.Context) *CreateTableBuilder {
+	return &CreateTableBuilder{
+		client:           client,
+		ctx:              ctx,
+		connectionString: client.connectionStringFor(ctx),
+	}
+}
+
+// Table sets the table name.
+func (ctb *CreateTableBuilder) Table(table string) *CreateTableBuilder {
+	ctb.tableName = table
+	return ctb
+}
+
+// Column adds a column, e.g. Column("id", godb.Int, godb.PrimaryKey()).
+func (ctb *CreateTableBuilder) Column(name string, colType ColumnType, opts ...ColumnOption) *CreateTableBuilder {
+	ctb.columns = append(ctb.columns, Column(name, colType, opts...))
+	return ctb
+}
+
+// Columns adds prepared column definitions.
+func (ctb *CreateTableBuilder) Columns(defs ...ColumnDef) *CreateTableBuilder {
+	ctb.columns = append(ctb.columns, defs...)
+	return ctb
+}
+
+// Timeout sets a deadline for this operation, overriding the client's default timeout.
+func (ctb *CreateTableBuilder) Timeout(d time.Duration) *CreateTableBuilder {
+	ctb.timeout = d
+	return ctb
+}
+
+// Exec creates the table.
+func (ctb *CreateTableBuilder) Exec() (_ string, err error) {
+	defer wrapOpError(&err, "CreateTable", ctb.tableName, proto.DatabaseService_CreateTable_FullMethodName, time.Now())
+	if ctb.tableName == "" {
+		return "", fmt.Errorf("table name is required")
+	}
+	if len(ctb.columns) == 0 {
+		return "", fmt.Errorf("at least one column is required")
+	}
+	req := &proto.CreateTableRequest{
+		TableName:        ctb.tableName,
+		Columns:          make(map[string]string, len(ctb.columns)),
+		ConnectionString: ctb.connectionString,
+		ColumnDefs:       make([]*proto.ColumnDef, 0, len(ctb.columns)),
+	}
+	for _, col := range ctb.columns {
+		def, err := guarded(ctb.client, func() (*proto.ColumnDef, error) {
+			return columnDefProto(col)
+		})
+		if err != nil {
+			return "", err
+		}
+		if _, dup := req.Columns[col.Name]; dup {
+			return "", fmt.Errorf("column %s defined twice", col.Name)
+		}
+		req.ColumnDefs = append(req.ColumnDefs, def)
+		// Servers that predate column_defs read the flattened declaration.
+		decl := columnDeclaration(def)
+		if col.RefTable != "" {
+			if col.RefColumn == "" {
+				return "", fmt.Errorf("column %s references %s without a column", col.Name, col.RefTable)
+			}
+			req.ForeignKeys = append(req.ForeignKeys, &proto.ForeignKey{
+				TableName:  ctb.tableName,
+				ColumnName: col.Name,
+				RefTable:   col.RefTable,
+				RefColumn:  col.RefColumn,
+				OnDelete:   col.OnDelete,
+			})
+			decl += fmt.Sprintf(" REFERENCES %s(%s) ON DELETE %s", col.RefTable, col.RefColumn, referentialActionSQL(col.OnDelete))
+		}
+		req.Columns[col.Name] = decl
+	}
+	ctx, cancel := withTimeout(ctb.ctx, ctb.timeout)
+	defer cancel()
+	resp, err := ctb.client.client.CreateTable(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Message, nil
+}
+
+// columnDefProto validates col and converts it for the wire.
+func columnDefProto(col ColumnDef) (*proto.ColumnDef, error) {
+	if col.Name == "" {
+		return nil, fmt.Errorf("column name is required")
+	}
+	if col.Type == "" {
+		return nil, fmt.Errorf("column %s has no type", col.Name)
+	}
+	def := &proto.ColumnDef{
+		Name:        col.Name,
+		Type:        string(col.Type),
+		PrimaryKey:  col.PrimaryKey,
+		NotNull:     col.NotNull,
+		Unique:      col.Unique,
+		GeneratedId: col.AutoID,
+	}
+	switch col.AutoID {
+	case proto.IdGeneration_ID_GENERATION_NONE:
+	case AutoIDSequence:
+		if col.Type != Int {
+			return nil, fmt.Errorf("column %s: sequence ids require type %s", col.Name, Int)
+		}
+	case AutoIDUUID:
+		if col.Type != Text {
+			return nil, fmt.Errorf("column %s: UUID ids require type %s", col.Name, Text)
+		}
+	default:
+		return nil, fmt.Errorf("column %s: unknown id generation %v", col.Name, col.AutoID)
+	}
+	if col.AutoID != proto.IdGeneration_ID_GENERATION_NONE && col.Default != nil {
+		return nil, fmt.Errorf("column %s: a generated id cannot have a default", col.Name)
+	}
+	if col.Default != nil {
+		literal, err := formatLiteral(col.Default)
+		if err != nil {
+			return nil, fmt.Errorf("column %s default: %w", col.Name, err)
+		}
+		def.HasDefault = true
+		def.DefaultValue = literal
+	}
+	return def, nil
+}
+
+// columnDeclaration renders def in the "TYPE CONSTRAINTS" form of the columns map.
+func columnDeclaration(def *proto.ColumnDef) string {
+	parts := []string{def.Type}
+	if def.PrimaryKey {
+		parts = append(parts, "PRIMARY KEY")
+	}
+	if def.GeneratedId == AutoIDSequence {
+		parts = append(parts, "AUTOINCREMENT")
+	}
+	if def.NotNull {
+		parts = append(parts, "NOT NULL")
+	}
+	if def.Unique {
+		parts = append(parts, "UNIQUE")
+	}
+	if def.HasDefault {
+		parts = append(parts, "DEFAULT "+def.DefaultValue)
+	}
+	return strings.Join(parts, " ")
+}
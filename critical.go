@@ -0,0 +1,97 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	criticalReadAttempts = 3
+	criticalReadBackoff  = 50 * time.Millisecond
+)
+
+// ReadCritical runs qb as a hardened read for data such as configuration or
+// balances. It always reads from the primary server, bypassing the cache and
+// ReadVerify, and waits for a connection to become ready instead of failing
+// fast while reconnecting. The server must return a checksum of the result,
+// which is checked before any decoding; a mismatch or a transient failure is
+// retried up to 3 times in total, on another connection when the client pools
+// several (see WithMaxConcurrentStreams). These attempts replace those of
+// WithRetry rather than adding to them.
+//
+// The read runs in the builder's context, so it joins the builder's
+// transaction and tenant; cancelling ctx also stops it.
+func (c *GoDBClient) ReadCritical(ctx context.Context, qb *QueryBuilder) (_ *proto.QueryDataResponse, err error) {
+	defer wrapOpError(&err, "ReadCritical", qb.tableName, proto.DatabaseService_QueryData_FullMethodName, time.Now())
+	req, err := qb.request()
+	if err != nil {
+		return nil, err
+	}
+	req.WantChecksum = true
+	readCtx, cancel := withTimeout(qb.ctx, qb.timeout)
+	defer cancel()
+	readCtx, stop := context.WithCancel(readCtx)
+	defer stop()
+	defer context.AfterFunc(ctx, stop)()
+	ctx = context.WithValue(readCtx, noRetryKey{}, true)
+	clients := c.criticalClients()
+	delay := criticalReadBackoff
+	for attempt := 0; ; attempt++ {
+		resp, err := clients[attempt%len(clients)].QueryData(ctx, req, grpc.WaitForReady(true))
+		if err == nil {
+			return resp, nil
+		}
+		if attempt+1 >= criticalReadAttempts || !(retryable(err) || status.Code(err) == codes.DataLoss) {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(delay/2 + time.Duration(rand.Int63n(int64(delay)))):
+		}
+		delay *= 2
+	}
+}
+
+// criticalClients returns a client for each pooled connection, or the client
+// itself without a pool.
+func (c *GoDBClient) criticalClients() []proto.DatabaseServiceClient {
+	if c.pool == nil {
+		return []proto.DatabaseServiceClient{c.client}
+	}
+	c.pool.mu.Lock()
+	defer c.pool.mu.Unlock()
+	clients := make([]proto.DatabaseServiceClient, len(c.pool.conns))
+	for i, pc := range c.pool.conns {
+		clients[i] = proto.NewDatabaseServiceClient(pc.conn)
+	}
+	return clients
+}
+
+// checksumInterceptor verifies the checksum of query results that asked for
+// one, as the server sent them.
+func checksumInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+		return err
+	}
+	q, ok := req.(*proto.QueryDataRequest)
+	if !ok || !q.WantChecksum {
+		return nil
+	}
+	resp := reply.(*proto.QueryDataResponse)
+	if resp.Checksum == "" {
+		return fmt.Errorf("server did not return a result checksum")
+	}
+	if sum := rowsChecksum(resp.Rows, true); sum != resp.Checksum {
+		return status.Errorf(codes.DataLoss, "result checksum mismatch: got %s, server sent %s", sum, resp.Checksum)
+	}
+	return nil
+}
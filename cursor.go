@@ -0,0 +1,124 @@
+package godb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// cursorPos is a keyset position: the cursor column's value in the last row
+// seen and, when that column is not id, the row's id to break ties.
+type cursorPos struct {
+	Column string `json:"c"`
+	Value  string `json:"v"`
+	ID     string `json:"i,omitempty"`
+	Desc   bool   `json:"d,omitempty"`
+}
+
+// token renders the position as an opaque cursor token.
+func (p *cursorPos) token() string {
+	b, _ := json.Marshal(p)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// parseCursorToken decodes a token produced by token.
+func parseCursorToken(token string) (*cursorPos, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor token")
+	}
+	var p cursorPos
+	if err := json.Unmarshal(b, &p); err != nil || p.Column == "" {
+		return nil, fmt.Errorf("invalid cursor token")
+	}
+	return &p, nil
+}
+
+// condition renders the rows that come after the position.
+func (p *cursorPos) condition() string {
+	op := ">"
+	if p.Desc {
+		op = "<"
+	}
+	value := cursorLiteral(p.Value)
+	if p.ID == "" || p.Column == "id" {
+		return fmt.Sprintf("%s %s %s", p.Column, op, value)
+	}
+	return fmt.Sprintf("(%s %s %s OR (%s = %s AND id %s %s))",
+		p.Column, op, value, p.Column, value, op, cursorLiteral(p.ID))
+}
+
+// order renders the ORDER BY clause the position was taken in.
+func (p *cursorPos) order() string {
+	dir := "ASC"
+	if p.Desc {
+		dir = "DESC"
+	}
+	if p.Column == "id" {
+		return "id " + dir
+	}
+	return p.Column + " " + dir + ", id " + dir
+}
+
+// CursorAfter starts the query after rows whose column has the given value,
+// in the direction the query is ordered by that column, e.g.
+//
+//	client.Query(ctx).Table("events").CursorAfter("created_at", ts).OrderBy("created_at DESC")
+//
+// returns events created before ts. Values are encoded like condition values.
+// column must lead the ORDER BY; without one the query is ordered ascending.
+func (qb *QueryBuilder) CursorAfter(column string, value interface{}) *QueryBuilder {
+	qb.after = &cursorPos{Column: column}
+	qb.afterValue = value
+	return qb
+}
+
+// ResumeFrom starts the query after the position in token, a Page.NextCursor
+// from an earlier Paginate. Without an ORDER BY the query takes the token's
+// order; an invalid token fails the query.
+func (qb *QueryBuilder) ResumeFrom(token string) *QueryBuilder {
+	qb.after, qb.cursorErr = parseCursorToken(token)
+	qb.afterValue = nil
+	return qb
+}
+
+// resolveCursor returns the query's cursor position with its value encoded and
+// its direction taken from the ORDER BY, or nil if none is set.
+func (qb *QueryBuilder) resolveCursor() (*cursorPos, error) {
+	if qb.cursorErr != nil {
+		return nil, qb.cursorErr
+	}
+	if qb.after == nil {
+		return nil, nil
+	}
+	pos := *qb.after
+	if qb.afterValue != nil {
+		value, err := guarded(qb.client, func() (string, error) {
+			return encodeValue(qb.afterValue)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cursor value: %w", err)
+		}
+		pos.Value = value
+	}
+	if qb.orderBy != "" {
+		column, desc := leadingOrder(qb.orderBy)
+		if column != pos.Column {
+			return nil, fmt.Errorf("cursor column %s must lead the ORDER BY", pos.Column)
+		}
+		pos.Desc = desc
+	}
+	return &pos, nil
+}
+
+// leadingOrder returns the first column of an ORDER BY clause and whether it
+// sorts descending.
+func leadingOrder(orderBy string) (column string, desc bool) {
+	first, _, _ := strings.Cut(orderBy, ",")
+	fields := strings.Fields(first)
+	if len(fields) == 0 {
+		return "", false
+	}
+	return fields[0], len(fields) > 1 && strings.EqualFold(fields[1], "DESC")
+}
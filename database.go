@@ -0,0 +1,164 @@
+package godb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// Database is a client scoped to one database. Its connection string is fixed
+// when it is created, so several Databases can be used concurrently from one
+// client.
+type Database struct {
+	client           *GoDBClient
+	name             string
+	connectionString string
+}
+
+// WithConnectionString sets the connection string used by the client's own
+// builders and as the template for Database.
+func WithConnectionString(connStr string) Option {
+	return func(o *clientOptions) {
+		o.connectionString = connStr
+	}
+}
+
+// Database returns a handle to the database name, using the credentials of the
+// client's connection string, e.g. "grpc://john:secret123/shop" becomes
+// "grpc://john:secret123/<name>".
+func (c *GoDBClient) Database(name string) *Database {
+	return &Database{
+		client:           c,
+		name:             name,
+		connectionString: withDatabaseName(c.connectionString, name),
+	}
+}
+
+// DatabaseFromConnectionString returns a handle to the database named in connStr.
+func (c *GoDBClient) DatabaseFromConnectionString(connStr string) *Database {
+	name := connStr
+	if i := strings.LastIndex(connStr, "/"); i >= 0 {
+		name = connStr[i+1:]
+	}
+	return &Database{
+		client:           c,
+		name:             name,
+		connectionString: connStr,
+	}
+}
+
+// withDatabaseName replaces the database name, the last path segment, of connStr.
+func withDatabaseName(connStr, name string) string {
+	prefix := connStr
+	if i := strings.Index(prefix, "://"); i >= 0 {
+		if j := strings.LastIndex(prefix, "/"); j > i+2 {
+			prefix = prefix[:j]
+		}
+	}
+	return prefix + "/" + name
+}
+
+// Name returns the database name.
+func (db *Database) Name() string {
+	return db.name
+}
+
+// ConnectionString returns the connection string bound to the database.
+func (db *Database) ConnectionString() string {
+	return db.connectionString
+}
+
+// Create creates the database on the server.
+func (db *Database) Create(ctx context.Context) (string, error) {
+	return db.client.CreateDatabase(ctx, db.connectionString)
+}
+
+// Drop deletes the database. Unless force is set the server refuses to drop a
+// database that still has tables.
+func (db *Database) Drop(ctx context.Context, force bool) (_ string, err error) {
+	defer wrapOpError(&err, "DropDatabase", "", proto.DatabaseService_DropDatabase_FullMethodName, time.Now())
+	resp, err := db.client.client.DropDatabase(ctx, &proto.DropDatabaseRequest{
+		ConnectionString: db.connectionString,
+		Force:            force,
+	})
+	if err != nil {
+		return "", err
+	}
+	if db.client.cache != nil {
+		db.client.cache.invalidate(ctx, connGenKey(db.connectionString))
+	}
+	return resp.Message, nil
+}
+
+// CreateTable creates a table in the database.
+func (db *Database) CreateTable(ctx context.Context, tableName string, columns map[string]string) (string, error) {
+	return db.client.CreateTable(ctx, tableName, columns, db.connectionString)
+}
+
+// DescribeTable reports whether a table exists and lists its columns.
+func (db *Database) DescribeTable(ctx context.Context, tableName string) (*proto.DescribeTableResponse, error) {
+	return db.client.DescribeTable(ctx, tableName, db.connectionString)
+}
+
+// ListTables returns the names of the tables in the database, sorted.
+func (db *Database) ListTables(ctx context.Context) ([]string, error) {
+	return db.client.listTables(ctx, db.connectionString)
+}
+
+// NewTable returns a CreateTableBuilder scoped to the database.
+func (db *Database) NewTable(ctx context.Context) *CreateTableBuilder {
+	b := db.client.NewTable(ctx)
+	b.connectionString = db.connectionString
+	return b
+}
+
+// UpdateTable returns an UpdateTableBuilder scoped to the database.
+func (db *Database) UpdateTable(ctx context.Context) *UpdateTableBuilder {
+	b := db.client.UpdateTable(ctx)
+	b.connectionString = db.connectionString
+	return b
+}
+
+// Insert returns an InsertBuilder scoped to the database.
+func (db *Database) Insert(ctx context.Context) *InsertBuilder {
+	b := db.client.Insert(ctx)
+	b.connectionString = db.connectionString
+	return b
+}
+
+// InsertMultiple returns an InsertMultipleBuilder scoped to the database.
+func (db *Database) InsertMultiple(ctx context.Context) *InsertMultipleBuilder {
+	b := db.client.InsertMultiple(ctx)
+	b.connectionString = db.connectionString
+	return b
+}
+
+// Upsert returns an UpsertBuilder scoped to the database.
+func (db *Database) Upsert(ctx context.Context) *UpsertBuilder {
+	b := db.client.Upsert(ctx)
+	b.connectionString = db.connectionString
+	return b
+}
+
+// Update returns an UpdateRecordBuilder scoped to the database.
+func (db *Database) Update(ctx context.Context) *UpdateRecordBuilder {
+	b := db.client.UpdateRecord(ctx)
+	b.connectionString = db.connectionString
+	return b
+}
+
+// Query returns a QueryBuilder scoped to the database.
+func (db *Database) Query(ctx context.Context) *QueryBuilder {
+	b := db.client.Query(ctx)
+	b.connectionString = db.connectionString
+	return b
+}
+
+// Delete returns a DeleteBuilder scoped to the database.
+func (db *Database) Delete(ctx context.Context) *DeleteBuilder {
+	b := db.client.Delete(ctx)
+	b.connectionString = db.connectionString
+	return b
+}
@@ -0,0 +1,91 @@
+package godb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact, string-backed decimal number for money and other values
+// that must never pass through float formatting. It is sent on the wire verbatim
+// and rendered as a bare numeric literal in conditions.
+type Decimal string
+
+// NewDecimal validates s as a decimal literal such as "-12.50" and returns it as a Decimal.
+func NewDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	if !isDecimalLiteral(s) {
+		return "", fmt.Errorf("invalid decimal %q", s)
+	}
+	return Decimal(s), nil
+}
+
+// MustDecimal is like NewDecimal but panics on invalid input. It is intended for constants.
+func MustDecimal(s string) Decimal {
+	d, err := NewDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// DecimalFromUnits builds a Decimal from an integer count of minor units,
+// e.g. DecimalFromUnits(1999, 2) is "19.99".
+func DecimalFromUnits(units int64, scale int) Decimal {
+	digits := strconv.FormatInt(units, 10)
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	if scale <= 0 {
+		return Decimal(sign + digits)
+	}
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return Decimal(sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:])
+}
+
+// String returns the decimal literal.
+func (d Decimal) String() string {
+	return string(d)
+}
+
+// RegisterDecimalType registers a third-party decimal type (for example
+// shopspring/decimal.Decimal) so it is encoded exactly and rendered unquoted in conditions:
+//
+//	godb.RegisterDecimalType(decimal.Decimal.String, decimal.NewFromString)
+func RegisterDecimalType[T any](format func(T) string, parse func(string) (T, error)) {
+	registerType(func(v T) (string, error) {
+		s := format(v)
+		if !isDecimalLiteral(s) {
+			return "", fmt.Errorf("invalid decimal %q", s)
+		}
+		return s, nil
+	}, parse, true)
+}
+
+// isDecimalLiteral reports whether s is an optionally signed base-10 number
+// with an optional fractional part.
+func isDecimalLiteral(s string) bool {
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	intPart, fracPart, hasDot := strings.Cut(s, ".")
+	if intPart == "" && fracPart == "" {
+		return false
+	}
+	if hasDot && fracPart == "" {
+		return false
+	}
+	for _, r := range intPart + fracPart {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func init() {
+	RegisterDecimalType(Decimal.String, NewDecimal)
+}
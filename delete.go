@@ -0,0 +1,143 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// DeleteBuilder provides a fluent interface for deleting records.
+type DeleteBuilder struct {
+	client           *GoDBClient
+	ctx              context.Context
+	connectionString string
+	tableName        string
+	conds            []Cond
+	deleteAll        bool
+	returning        []string
+	timeout          time.Duration
+}
+
+// Delete returns a new DeleteBuilder using the client's stored connection string.
+func (client *GoDBClient) Delete(ctx context.Context) *DeleteBuilder {
+	return &DeleteBuilder{
+		client:           client,
+		ctx:              ctx,
+		connectionString: client.connectionStringFor(ctx),
+	}
+}
+
+// Table sets the table name.
+func (db *DeleteBuilder) Table(table string) *DeleteBuilder {
+	db.tableName = table
+	return db
+}
+
+// Condition sets a custom WHERE condition, replacing any conditions added so far.
+// Named parameters written :name take their values from params, as with Named.
+func (db *DeleteBuilder) Condition(cond string, params ...Params) *DeleteBuilder {
+	db.conds = []Cond{conditionText(cond, params)}
+	return db
+}
+
+// Where adds a condition expression, ANDed with the existing conditions.
+func (db *DeleteBuilder) Where(cond Cond) *DeleteBuilder {
+	db.conds = append(db.conds, cond)
+	return db
+}
+
+// Equal adds an equality condition.
+func (db *DeleteBuilder) Equal(field string, value interface{}) *DeleteBuilder {
+	return db.Where(Eq(field, value))
+}
+
+// Greater adds a greater-than condition.
+func (db *DeleteBuilder) Greater(field string, value interface{}) *DeleteBuilder {
+	return db.Where(Gt(field, value))
+}
+
+// Less adds a less-than condition.
+func (db *DeleteBuilder) Less(field string, value interface{}) *DeleteBuilder {
+	return db.Where(Lt(field, value))
+}
+
+// DeleteAll confirms that the delete is meant to remove every row of the table.
+// It cannot be combined with conditions.
+func (db *DeleteBuilder) DeleteAll() *DeleteBuilder {
+	db.deleteAll = true
+	return db
+}
+
+// Returning names columns of each deleted row to return in the Result of
+// ExecResult, e.g. for audit logs.
+func (db *DeleteBuilder) Returning(columns ...string) *DeleteBuilder {
+	db.returning = append(db.returning, columns...)
+	return db
+}
+
+// Timeout sets a deadline for this operation, overriding the client's default timeout.
+func (db *DeleteBuilder) Timeout(d time.Duration) *DeleteBuilder {
+	db.timeout = d
+	return db
+}
+
+// Exec executes the delete operation. A condition, or DeleteAll, is required
+// so that a forgotten filter cannot wipe the whole table.
+func (db *DeleteBuilder) Exec() (string, error) {
+	resp, err := db.exec()
+	if err != nil {
+		return "", err
+	}
+	return resp.Message, nil
+}
+
+// ExecCount executes the delete operation and returns the number of rows
+// deleted, as reported by the server.
+func (db *DeleteBuilder) ExecCount() (int64, error) {
+	resp, err := db.exec()
+	if err != nil {
+		return 0, err
+	}
+	return resp.RowsDeleted, nil
+}
+
+// ExecResult executes the delete operation and reports its effect.
+func (db *DeleteBuilder) ExecResult() (Result, error) {
+	resp, err := db.exec()
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{RowsAffected: resp.RowsDeleted, Message: resp.Message, Returned: resp.Returned}, nil
+}
+
+// exec sends the DeleteRecord request.
+func (db *DeleteBuilder) exec() (_ *proto.DeleteRecordResponse, err error) {
+	defer wrapOpError(&err, "Delete", db.tableName, proto.DatabaseService_DeleteRecord_FullMethodName, time.Now())
+	if db.tableName == "" {
+		return nil, fmt.Errorf("table name is required")
+	}
+	condition, err := guarded(db.client, func() (string, error) {
+		return renderCond(And(db.conds...))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if db.deleteAll && condition != "" {
+		return nil, fmt.Errorf("DeleteAll cannot be combined with a condition")
+	}
+	if condition == "" && !db.deleteAll {
+		return nil, fmt.Errorf("condition is required; use DeleteAll to delete every row")
+	}
+	req := &proto.DeleteRecordRequest{
+		TableName:        db.tableName,
+		Condition:        condition,
+		ConnectionString: db.connectionString,
+		DeleteAll:        db.deleteAll,
+		Returning:        db.returning,
+	}
+	ctx, cancel := withTimeout(db.ctx, db.timeout)
+	defer cancel()
+	return db.client.client.DeleteRecord(ctx, req)
+}
@@ -0,0 +1,188 @@
+package godb
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// minDNSInterval limits how often failing connections can trigger lookups.
+	minDNSInterval = time.Second
+	dnsTimeout     = 10 * time.Second
+)
+
+// DNSPolicy controls how the client re-resolves the server's host name, for
+// servers whose address changes, such as containers that get a new IP on
+// restart. It applies to addresses without a scheme or with the dns scheme.
+type DNSPolicy struct {
+	// RefreshInterval re-resolves the host name this often, even while the
+	// connection looks healthy. Zero re-resolves only when a connection fails.
+	RefreshInterval time.Duration
+	// ReresolveAfter re-resolves the host name and reconnects without backoff
+	// once this many consecutive calls fail with Unavailable or
+	// DeadlineExceeded, which is how a connection to an address that silently
+	// went away shows up. Zero disables it.
+	ReresolveAfter int
+}
+
+// WithDNSPolicy sets how the client re-resolves the server's host name. By
+// default gRPC re-resolves only when a connection fails, and at most every 30
+// seconds.
+func WithDNSPolicy(p DNSPolicy) Option {
+	return func(o *clientOptions) {
+		b := &dnsBuilder{refresh: p.RefreshInterval, resolvers: make(map[*dnsResolver]struct{})}
+		o.dialOptions = append(o.dialOptions, grpc.WithResolvers(b))
+		o.dnsReresolve = nil
+		if p.ReresolveAfter > 0 {
+			o.dnsReresolve = &dnsReresolver{builder: b, after: int32(p.ReresolveAfter)}
+		}
+	}
+}
+
+// dnsBuilder builds the resolvers of a client's connections, replacing gRPC's
+// dns resolver for them.
+type dnsBuilder struct {
+	refresh time.Duration
+
+	mu        sync.Mutex
+	resolvers map[*dnsResolver]struct{}
+}
+
+func (b *dnsBuilder) Scheme() string {
+	return "dns"
+}
+
+func (b *dnsBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	host, port, err := net.SplitHostPort(target.Endpoint())
+	if err != nil {
+		host, port = target.Endpoint(), "443"
+	}
+	r := &dnsResolver{
+		builder: b,
+		host:    host,
+		port:    port,
+		cc:      cc,
+		now:     make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	b.mu.Lock()
+	b.resolvers[r] = struct{}{}
+	b.mu.Unlock()
+	go r.run()
+	return r, nil
+}
+
+// resolveNow asks every open resolver to look the host up again.
+func (b *dnsBuilder) resolveNow() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for r := range b.resolvers {
+		r.ResolveNow(resolver.ResolveNowOptions{})
+	}
+}
+
+// dnsResolver looks a host up on demand and every refresh interval.
+type dnsResolver struct {
+	builder    *dnsBuilder
+	host, port string
+	cc         resolver.ClientConn
+
+	now       chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// ResolveNow is called by gRPC when a connection fails.
+func (r *dnsResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.now <- struct{}{}:
+	default:
+	}
+}
+
+func (r *dnsResolver) Close() {
+	r.closeOnce.Do(func() {
+		close(r.done)
+		r.builder.mu.Lock()
+		delete(r.builder.resolvers, r)
+		r.builder.mu.Unlock()
+	})
+}
+
+// run resolves the host until the resolver is closed.
+func (r *dnsResolver) run() {
+	var tick <-chan time.Time
+	if r.builder.refresh > 0 {
+		t := time.NewTicker(r.builder.refresh)
+		defer t.Stop()
+		tick = t.C
+	}
+	for {
+		last := time.Now()
+		r.resolve()
+		select {
+		case <-r.done:
+			return
+		case <-tick:
+		case <-r.now:
+			if wait := time.Until(last.Add(minDNSInterval)); wait > 0 {
+				select {
+				case <-r.done:
+					return
+				case <-time.After(wait):
+				}
+			}
+		}
+	}
+}
+
+// resolve looks the host up and hands its addresses to gRPC.
+func (r *dnsResolver) resolve() {
+	ctx, cancel := context.WithTimeout(context.Background(), dnsTimeout)
+	defer cancel()
+	hosts, err := net.DefaultResolver.LookupHost(ctx, r.host)
+	if err != nil {
+		r.cc.ReportError(err)
+		return
+	}
+	addrs := make([]resolver.Address, len(hosts))
+	for i, h := range hosts {
+		addrs[i] = resolver.Address{Addr: net.JoinHostPort(h, r.port)}
+	}
+	if err := r.cc.UpdateState(resolver.State{Addresses: addrs}); err != nil {
+		r.cc.ReportError(err)
+	}
+}
+
+// dnsReresolver forces a re-resolve after consecutive connection failures.
+type dnsReresolver struct {
+	builder  *dnsBuilder
+	after    int32
+	failures atomic.Int32
+}
+
+// interceptor counts consecutive calls failing as if the server were gone.
+func (d *dnsReresolver) interceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		if d.failures.Add(1) >= d.after {
+			d.failures.Store(0)
+			d.builder.resolveNow()
+			if cc != nil {
+				cc.ResetConnectBackoff()
+			}
+		}
+	default:
+		d.failures.Store(0)
+	}
+	return err
+}
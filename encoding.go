@@ -0,0 +1,233 @@
+package godb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Encoded values start with encodingMarker and a letter naming the encoding,
+// so values written before an encoding was configured still read back as is.
+const encodingMarker = "\x1f"
+
+// ColumnEncoding shrinks a column's values on the wire. Values are encoded on
+// insert, upsert, and update and decoded in query results; conditions are sent
+// unchanged, so do not filter on encoded columns.
+type ColumnEncoding interface {
+	encode(value string) string
+	decode(value string) (string, error)
+}
+
+// WithColumnEncoding applies enc to column of every table with the given name.
+func WithColumnEncoding(table, column string, enc ColumnEncoding) Option {
+	return func(o *clientOptions) {
+		if o.encodings == nil {
+			o.encodings = make(columnEncodings)
+		}
+		if o.encodings[table] == nil {
+			o.encodings[table] = make(map[string]ColumnEncoding)
+		}
+		o.encodings[table][column] = enc
+	}
+}
+
+// DictionaryEncoding replaces each of values with its short index, for
+// low-cardinality columns such as statuses. Other values pass through. Every
+// client reading the column must use the same dictionary in the same order;
+// append new values at the end.
+func DictionaryEncoding(values ...string) ColumnEncoding {
+	d := &dictionaryEncoding{values: values, index: make(map[string]int, len(values))}
+	for i, v := range values {
+		if _, dup := d.index[v]; !dup {
+			d.index[v] = i
+		}
+	}
+	return d
+}
+
+// dictionaryEncoding maps known values to their index.
+type dictionaryEncoding struct {
+	values []string
+	index  map[string]int
+}
+
+func (d *dictionaryEncoding) encode(value string) string {
+	if i, ok := d.index[value]; ok {
+		return encodingMarker + "d" + strconv.Itoa(i)
+	}
+	return value
+}
+
+func (d *dictionaryEncoding) decode(value string) (string, error) {
+	i, err := strconv.Atoi(value)
+	if err != nil || i < 0 || i >= len(d.values) {
+		return "", fmt.Errorf("unknown dictionary index %q", value)
+	}
+	return d.values[i], nil
+}
+
+// CompressEncoding gzips values of at least minSize bytes, for large text
+// fields. Smaller values pass through, since compression would grow them.
+func CompressEncoding(minSize int) ColumnEncoding {
+	return compressEncoding{minSize: minSize}
+}
+
+// compressEncoding gzips large values and base64-encodes the result.
+type compressEncoding struct {
+	minSize int
+}
+
+func (c compressEncoding) encode(value string) string {
+	if len(value) < c.minSize {
+		return value
+	}
+	var b bytes.Buffer
+	zw := gzip.NewWriter(&b)
+	zw.Write([]byte(value))
+	zw.Close()
+	encoded := encodingMarker + "z" + base64.StdEncoding.EncodeToString(b.Bytes())
+	if len(encoded) >= len(value) {
+		return value
+	}
+	return encoded
+}
+
+func (c compressEncoding) decode(value string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", err
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return "", err
+	}
+	out, err := io.ReadAll(zr)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// columnEncodings maps table and column names to their encodings.
+type columnEncodings map[string]map[string]ColumnEncoding
+
+// encodeRecord returns rec with the table's encodings applied, or rec itself
+// when nothing changes. Values already carrying the marker are left alone so
+// re-sent requests are not encoded twice.
+func (ce columnEncodings) encodeRecord(table string, rec map[string]string) map[string]string {
+	cols := ce[table]
+	var out map[string]string
+	for col, enc := range cols {
+		v, ok := rec[col]
+		if !ok || strings.HasPrefix(v, encodingMarker) {
+			continue
+		}
+		if encoded := enc.encode(v); encoded != v {
+			if out == nil {
+				out = make(map[string]string, len(rec))
+				for k, v := range rec {
+					out[k] = v
+				}
+			}
+			out[col] = encoded
+		}
+	}
+	if out == nil {
+		return rec
+	}
+	return out
+}
+
+// dropTyped removes the typed values of encoded columns, leaving the string
+// forms, which carry the encoding, to stand for them. NULLs have no string
+// form and are kept.
+func (ce columnEncodings) dropTyped(table string, typed map[string]*proto.Value) {
+	for col := range ce[table] {
+		if _, null := typed[col].GetKind().(*proto.Value_NullValue); !null {
+			delete(typed, col)
+		}
+	}
+}
+
+// decodeRow decodes the encoded values of row in place.
+func (ce columnEncodings) decodeRow(table string, row map[string]string) error {
+	for col, enc := range ce[table] {
+		v, ok := row[col]
+		if !ok || !strings.HasPrefix(v, encodingMarker) || len(v) < len(encodingMarker)+1 {
+			continue
+		}
+		var expected byte
+		switch enc.(type) {
+		case *dictionaryEncoding:
+			expected = 'd'
+		case compressEncoding:
+			expected = 'z'
+		}
+		if v[len(encodingMarker)] != expected {
+			return fmt.Errorf("column %s: value was written with a different encoding", col)
+		}
+		decoded, err := enc.decode(v[len(encodingMarker)+1:])
+		if err != nil {
+			return fmt.Errorf("decoding column %s: %w", col, err)
+		}
+		row[col] = decoded
+	}
+	return nil
+}
+
+// interceptor encodes writes and decodes query results.
+func (ce columnEncodings) interceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	tr, ok := req.(tableRequest)
+	if !ok || ce[tr.GetTableName()] == nil {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	table := tr.GetTableName()
+	switch r := req.(type) {
+	case *proto.InsertRecordRequest:
+		r = protobuf.Clone(r).(*proto.InsertRecordRequest)
+		r.Record = ce.encodeRecord(table, r.Record)
+		ce.dropTyped(table, r.TypedRecord)
+		req = r
+	case *proto.InsertMultipleRecordsRequest:
+		r = protobuf.Clone(r).(*proto.InsertMultipleRecordsRequest)
+		for _, rec := range r.Records {
+			rec.Data = ce.encodeRecord(table, rec.Data)
+			ce.dropTyped(table, rec.TypedData)
+		}
+		req = r
+	case *proto.UpsertRecordRequest:
+		r = protobuf.Clone(r).(*proto.UpsertRecordRequest)
+		r.Record = ce.encodeRecord(table, r.Record)
+		ce.dropTyped(table, r.TypedRecord)
+		req = r
+	case *proto.UpdateRecordRequest:
+		r = protobuf.Clone(r).(*proto.UpdateRecordRequest)
+		r.Updates = ce.encodeRecord(table, r.Updates)
+		ce.dropTyped(table, r.TypedUpdates)
+		req = r
+	}
+	if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+		return err
+	}
+	rows := append(changeImages(reply), returnedRows(reply)...)
+	if resp, ok := reply.(*proto.QueryDataResponse); ok {
+		rows = resp.Rows
+	}
+	for _, row := range rows {
+		if err := ce.decodeRow(table, row.Data); err != nil {
+			return err
+		}
+		ce.dropTyped(table, row.Values)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package godb
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// OpError describes a failed SDK operation. Every error returned by the client
+// and its builders can be unwrapped into an *OpError with errors.As.
+type OpError struct {
+	// Op is the SDK operation, e.g. "Insert" or "CreateTable".
+	Op string
+	// Table is the table the operation targeted, if any.
+	Table string
+	// Method is the full gRPC method name, if the operation calls the server.
+	Method string
+	// Duration is how long the operation ran before failing.
+	Duration time.Duration
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *OpError) Error() string {
+	if e.Table != "" {
+		return fmt.Sprintf("godb: %s %s: %v", e.Op, e.Table, e.Err)
+	}
+	return fmt.Sprintf("godb: %s: %v", e.Op, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *OpError) Unwrap() error {
+	return e.Err
+}
+
+// wrapOpError is deferred by operations to attach context to a non-nil *errp.
+// Errors that already carry an *OpError from a nested operation are left as is.
+func wrapOpError(errp *error, op, table, method string, start time.Time) {
+	if *errp == nil {
+		return
+	}
+	var existing *OpError
+	if errors.As(*errp, &existing) {
+		return
+	}
+	*errp = &OpError{
+		Op:       op,
+		Table:    table,
+		Method:   method,
+		Duration: time.Since(start),
+		Err:      *errp,
+	}
+}
@@ -0,0 +1,47 @@
+package godb
+
+import (
+	"context"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// Explain returns the plan the server would use to run qb, including the scan
+// type, index, and estimated row count of each step. The query is not run.
+func (c *GoDBClient) Explain(ctx context.Context, qb *QueryBuilder) (_ *proto.QueryPlan, err error) {
+	defer wrapOpError(&err, "Explain", qb.tableName, proto.DatabaseService_Explain_FullMethodName, time.Now())
+	query, err := qb.request()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Explain(ctx, &proto.ExplainRequest{Query: query})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Plan, nil
+}
+
+// IndexStats reports how often each index on table has been used since the
+// server started, to check that an index actually serves queries.
+func (c *GoDBClient) IndexStats(ctx context.Context, table string) ([]*proto.IndexStat, error) {
+	return c.indexStats(ctx, c.connectionStringFor(ctx), table)
+}
+
+// IndexStats reports how often each index on the table has been used.
+func (t *Table) IndexStats(ctx context.Context) ([]*proto.IndexStat, error) {
+	return t.client.indexStats(ctx, t.connString(ctx), t.name)
+}
+
+// indexStats calls IndexStats with connStr.
+func (c *GoDBClient) indexStats(ctx context.Context, connStr, table string) (_ []*proto.IndexStat, err error) {
+	defer wrapOpError(&err, "IndexStats", table, proto.DatabaseService_IndexStats_FullMethodName, time.Now())
+	resp, err := c.client.IndexStats(ctx, &proto.IndexStatsRequest{
+		TableName:        table,
+		ConnectionString: connStr,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Stats, nil
+}
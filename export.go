@@ -0,0 +1,133 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// defaultExportPageSize is the number of rows fetched per page by ExportAll.
+const defaultExportPageSize = 1000
+
+// ExportOption configures ExportAll.
+type ExportOption func(*exportConfig)
+
+// exportConfig holds the ExportAll settings.
+type exportConfig struct {
+	pageSize   int
+	cursor     string
+	checkpoint func(cursor string) error
+}
+
+// WithPageSize sets the number of rows fetched and delivered per page.
+func WithPageSize(n int) ExportOption {
+	return func(c *exportConfig) {
+		c.pageSize = n
+	}
+}
+
+// WithResumeCursor resumes an export after the row with the given id, as
+// reported to a checkpoint function by an earlier run.
+func WithResumeCursor(cursor string) ExportOption {
+	return func(c *exportConfig) {
+		c.cursor = cursor
+	}
+}
+
+// WithCheckpoint calls fn with the cursor of the last delivered row after each
+// page, so an interrupted export can be resumed with WithResumeCursor. An error
+// from fn stops the export.
+func WithCheckpoint(fn func(cursor string) error) ExportOption {
+	return func(c *exportConfig) {
+		c.checkpoint = fn
+	}
+}
+
+// ExportAll walks every row of table in id order, one page at a time, and
+// delivers each page to sink. Pages are fetched with keyset pagination on the
+// "id" column, so rows inserted during the export are picked up if their id is
+// past the current position. A table without an id column cannot be paged and
+// fails once it has more than one page of rows. Page fetches are retried like
+// any other read by the client's WithRetry policy. It returns the number of
+// rows delivered, which is also reported when the export stops part way.
+func (c *GoDBClient) ExportAll(ctx context.Context, table string, sink ResultSink, opts ...ExportOption) (_ int, err error) {
+	defer wrapOpError(&err, "ExportAll", table, "", time.Now())
+	cfg := &exportConfig{pageSize: defaultExportPageSize}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.pageSize <= 0 {
+		return 0, fmt.Errorf("page size must be positive")
+	}
+
+	exported := 0
+	cursor := cfg.cursor
+	for {
+		result, err := c.exportPage(ctx, table, cursor, cfg.pageSize)
+		if err != nil {
+			return exported, err
+		}
+		if len(result.Rows) == 0 {
+			return exported, nil
+		}
+		if err := sink.Send(ctx, result); err != nil {
+			return exported, fmt.Errorf("sink: %w", err)
+		}
+		exported += len(result.Rows)
+		if len(result.Rows) < cfg.pageSize {
+			return exported, checkpoint(cfg, result.NextCursor)
+		}
+		if result.NextCursor == "" || result.NextCursor == cursor {
+			return exported, fmt.Errorf("cursor did not advance past %q; does the table have an id column?", cursor)
+		}
+		cursor = result.NextCursor
+		if err := checkpoint(cfg, cursor); err != nil {
+			return exported, err
+		}
+	}
+}
+
+// checkpoint reports cursor to the configured checkpoint function, if any.
+func checkpoint(cfg *exportConfig, cursor string) error {
+	if cfg.checkpoint == nil || cursor == "" {
+		return nil
+	}
+	if err := cfg.checkpoint(cursor); err != nil {
+		return fmt.Errorf("checkpoint: %w", err)
+	}
+	return nil
+}
+
+// exportPage fetches the page after cursor.
+func (c *GoDBClient) exportPage(ctx context.Context, table, cursor string, pageSize int) (*QueryResult, error) {
+	qb := c.Query(ctx).Table(table).OrderBy("id ASC").Limit(pageSize)
+	if cursor != "" {
+		qb.Cursor(cursor)
+	}
+	resp, err := qb.Exec()
+	if err != nil {
+		return nil, err
+	}
+	result := &QueryResult{
+		Table:     table,
+		Columns:   resp.Columns,
+		Rows:      make([]map[string]string, len(resp.Rows)),
+		QueriedAt: time.Now().UTC(),
+	}
+	for i, row := range resp.Rows {
+		result.Rows[i] = row.Data
+	}
+	if n := len(result.Rows); n > 0 {
+		result.NextCursor = result.Rows[n-1]["id"]
+	}
+	return result, nil
+}
+
+// cursorLiteral renders an id for the cursor condition, quoting non-numeric ids.
+func cursorLiteral(id string) string {
+	if _, err := strconv.ParseFloat(id, 64); err == nil {
+		return id
+	}
+	return quoteString(id)
+}
@@ -0,0 +1,197 @@
+package godb
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// ExportFormat is the file format written by an ExportBuilder.
+type ExportFormat int
+
+const (
+	// CSV writes delimited text with one record per row, NULLs as empty fields.
+	CSV ExportFormat = iota
+	// JSONLines writes one JSON object per row, leaving out NULL columns.
+	JSONLines
+)
+
+// ExportBuilder provides a fluent interface for writing a table or query
+// result to a file or other writer.
+type ExportBuilder struct {
+	client    *GoDBClient
+	ctx       context.Context
+	tableName string
+	query     *QueryBuilder
+	format    ExportFormat
+	delimiter rune
+	header    bool
+	gzip      bool
+	opts      []ExportOption
+}
+
+// Export returns a new ExportBuilder writing CSV with a header line.
+func (client *GoDBClient) Export(ctx context.Context) *ExportBuilder {
+	return &ExportBuilder{
+		client:    client,
+		ctx:       ctx,
+		format:    CSV,
+		delimiter: ',',
+		header:    true,
+	}
+}
+
+// Table exports every row of table, fetched page by page in id order as
+// ExportAll does.
+func (eb *ExportBuilder) Table(table string) *ExportBuilder {
+	eb.tableName = table
+	return eb
+}
+
+// Query exports the result of qb instead of a whole table.
+func (eb *ExportBuilder) Query(qb *QueryBuilder) *ExportBuilder {
+	eb.query = qb
+	return eb
+}
+
+// Format sets the output format. The default is CSV.
+func (eb *ExportBuilder) Format(f ExportFormat) *ExportBuilder {
+	eb.format = f
+	return eb
+}
+
+// Delimiter sets the CSV field delimiter, e.g. '\t' or ';'. The default is ','.
+func (eb *ExportBuilder) Delimiter(r rune) *ExportBuilder {
+	eb.delimiter = r
+	return eb
+}
+
+// Header sets whether CSV output starts with a line of column names. The
+// default is true.
+func (eb *ExportBuilder) Header(on bool) *ExportBuilder {
+	eb.header = on
+	return eb
+}
+
+// Gzip compresses the output with gzip.
+func (eb *ExportBuilder) Gzip() *ExportBuilder {
+	eb.gzip = true
+	return eb
+}
+
+// Options sets the ExportAll options used for table exports, such as the page
+// size and retries.
+func (eb *ExportBuilder) Options(opts ...ExportOption) *ExportBuilder {
+	eb.opts = append(eb.opts, opts...)
+	return eb
+}
+
+// To writes the export to w and returns the number of rows written. Columns
+// are taken from the first page and kept for the rest of the export.
+func (eb *ExportBuilder) To(w io.Writer) (_ int, err error) {
+	table := eb.tableName
+	if eb.query != nil {
+		table = eb.query.tableName
+	}
+	defer wrapOpError(&err, "Export", table, "", time.Now())
+	switch {
+	case eb.tableName == "" && eb.query == nil:
+		return 0, fmt.Errorf("table name or query is required")
+	case eb.tableName != "" && eb.query != nil:
+		return 0, fmt.Errorf("table and query cannot be combined")
+	}
+	var gz *gzip.Writer
+	if eb.gzip {
+		gz = gzip.NewWriter(w)
+		w = gz
+	}
+	sink := &writerSink{format: eb.format, header: eb.header}
+	switch eb.format {
+	case CSV:
+		sink.csv = csv.NewWriter(w)
+		sink.csv.Comma = eb.delimiter
+	case JSONLines:
+		sink.json = json.NewEncoder(w)
+	default:
+		return 0, fmt.Errorf("unknown export format %d", eb.format)
+	}
+	if eb.query != nil {
+		err = eb.query.SendTo(sink)
+	} else {
+		_, err = eb.client.ExportAll(eb.ctx, eb.tableName, sink, eb.opts...)
+	}
+	if err != nil {
+		return sink.rows, err
+	}
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return sink.rows, err
+		}
+	}
+	return sink.rows, nil
+}
+
+// writerSink is a ResultSink encoding rows to a writer.
+type writerSink struct {
+	format  ExportFormat
+	header  bool
+	csv     *csv.Writer
+	json    *json.Encoder
+	columns []string
+	rows    int
+}
+
+// Send implements ResultSink.
+func (s *writerSink) Send(ctx context.Context, result *QueryResult) error {
+	if s.format == JSONLines {
+		for _, row := range result.Rows {
+			if err := s.json.Encode(row); err != nil {
+				return err
+			}
+			s.rows++
+		}
+		return nil
+	}
+	if s.columns == nil {
+		s.columns = resultColumns(result)
+		if s.header {
+			if err := s.csv.Write(s.columns); err != nil {
+				return err
+			}
+		}
+	}
+	record := make([]string, len(s.columns))
+	for _, row := range result.Rows {
+		for i, col := range s.columns {
+			record[i] = row[col]
+		}
+		if err := s.csv.Write(record); err != nil {
+			return err
+		}
+		s.rows++
+	}
+	s.csv.Flush()
+	return s.csv.Error()
+}
+
+// resultColumns returns the columns of result in select order, or sorted when
+// the server does not describe them.
+func resultColumns(result *QueryResult) []string {
+	columns := make([]string, 0, len(result.Columns))
+	for _, col := range result.Columns {
+		columns = append(columns, col.Name)
+	}
+	if len(columns) > 0 || len(result.Rows) == 0 {
+		return columns
+	}
+	for col := range result.Rows[0] {
+		columns = append(columns, col)
+	}
+	sort.Strings(columns)
+	return columns
+}
@@ -0,0 +1,226 @@
+package godb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+	"google.golang.org/grpc"
+)
+
+// ErrSchemaMismatch is matched by errors.Is when a table's schema differs from
+// its pinned fingerprint.
+var ErrSchemaMismatch = errors.New("schema does not match pinned fingerprint")
+
+// SchemaMismatchError reports a table whose schema differs from its pinned fingerprint.
+type SchemaMismatchError struct {
+	Database string
+	Table    string
+	Expected string
+	Actual   string
+}
+
+// Error implements the error interface.
+func (e *SchemaMismatchError) Error() string {
+	return fmt.Sprintf("table %s in database %s: schema fingerprint %s does not match pinned %s; have the migrations been applied?", e.Table, e.Database, e.Actual, e.Expected)
+}
+
+// Is makes SchemaMismatchError match ErrSchemaMismatch.
+func (e *SchemaMismatchError) Is(target error) bool {
+	return target == ErrSchemaMismatch
+}
+
+// WithSchemaFingerprint pins the expected schema fingerprint of table in every
+// database, as returned by SchemaFingerprint. A pin set for one database with
+// WithDatabaseSchemaFingerprint takes precedence there.
+//
+// A pinned table is verified before the first call that reads or writes its
+// rows in each database, and again after the client changes its schema; a
+// mismatch fails the call with a *SchemaMismatchError. The pin is also checked
+// by VerifySchema and whenever the client describes the table. AutoMigrate
+// and other schema changes are never blocked, since they are what brings the
+// table up to date.
+func WithSchemaFingerprint(table, fingerprint string) Option {
+	return WithDatabaseSchemaFingerprint("", table, fingerprint)
+}
+
+// WithDatabaseSchemaFingerprint pins the expected schema fingerprint of table
+// in the named database only, as WithSchemaFingerprint does for every database.
+func WithDatabaseSchemaFingerprint(database, table, fingerprint string) Option {
+	return func(o *clientOptions) {
+		if o.schemaPins == nil {
+			o.schemaPins = &schemaPins{
+				pins:     make(map[schemaPinKey]string),
+				verified: make(map[schemaPinKey]bool),
+			}
+		}
+		o.schemaPins.pins[schemaPinKey{database: database, table: table}] = fingerprint
+	}
+}
+
+// SchemaFingerprint returns a stable hash of a table's column names and types,
+// independent of column order. A missing table has the fingerprint of no columns.
+func SchemaFingerprint(desc *proto.DescribeTableResponse) string {
+	cols := make([]string, len(desc.Columns))
+	for i, col := range desc.Columns {
+		cols[i] = strings.ToLower(col.Name) + " " + strings.ToUpper(strings.TrimSpace(col.Type))
+	}
+	sort.Strings(cols)
+	sum := sha256.Sum256([]byte(strings.Join(cols, "\n")))
+	return hex.EncodeToString(sum[:16])
+}
+
+// SchemaFingerprint describes table and returns its fingerprint, e.g. to pin it
+// with WithSchemaFingerprint.
+func (c *GoDBClient) SchemaFingerprint(ctx context.Context, table string) (_ string, err error) {
+	defer wrapOpError(&err, "SchemaFingerprint", table, proto.DatabaseService_DescribeTable_FullMethodName, time.Now())
+	desc, err := c.client.DescribeTable(ctx, &proto.DescribeTableRequest{
+		TableName:        table,
+		ConnectionString: c.connectionStringFor(ctx),
+	})
+	if err != nil {
+		return "", err
+	}
+	return SchemaFingerprint(desc), nil
+}
+
+// VerifySchema describes every pinned table and fails with a
+// *SchemaMismatchError, wrapped in an *OpError, for the first one whose schema
+// differs, so an application can refuse to start against an unmigrated
+// environment. Tables pinned in every database are checked in the database of
+// the client's connection string for ctx.
+func (c *GoDBClient) VerifySchema(ctx context.Context) error {
+	if c.schemaPins == nil {
+		return nil
+	}
+	connStr := c.connectionStringFor(ctx)
+	for _, key := range c.schemaPins.keys() {
+		pinConnStr := connStr
+		if key.database != "" {
+			pinConnStr = withDatabaseName(connStr, key.database)
+		}
+		if _, err := c.DescribeTable(ctx, key.table, pinConnStr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSchemaPin compares desc with the fingerprint pinned for table in the
+// database of connStr, if any.
+func (c *GoDBClient) checkSchemaPin(connStr, table string, desc *proto.DescribeTableResponse) error {
+	if c.schemaPins == nil {
+		return nil
+	}
+	_, database := connectionStringParts(connStr)
+	return c.schemaPins.check(database, table, desc)
+}
+
+// schemaPinKey names a pinned table. An empty database pins the table in
+// every database.
+type schemaPinKey struct {
+	database string
+	table    string
+}
+
+// schemaPins holds the pinned fingerprints and the tables verified against
+// them, per database.
+type schemaPins struct {
+	pins map[schemaPinKey]string
+
+	mu       sync.Mutex
+	verified map[schemaPinKey]bool
+}
+
+// keys returns the pins in a stable order.
+func (p *schemaPins) keys() []schemaPinKey {
+	keys := make([]schemaPinKey, 0, len(p.pins))
+	for key := range p.pins {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].database != keys[j].database {
+			return keys[i].database < keys[j].database
+		}
+		return keys[i].table < keys[j].table
+	})
+	return keys
+}
+
+// expected returns the fingerprint pinned for table in database.
+func (p *schemaPins) expected(database, table string) (string, bool) {
+	if fp, ok := p.pins[schemaPinKey{database: database, table: table}]; ok {
+		return fp, true
+	}
+	fp, ok := p.pins[schemaPinKey{table: table}]
+	return fp, ok
+}
+
+// check compares desc with the pin of table in database and records the
+// outcome.
+func (p *schemaPins) check(database, table string, desc *proto.DescribeTableResponse) error {
+	expected, ok := p.expected(database, table)
+	if !ok {
+		return nil
+	}
+	key := schemaPinKey{database: database, table: table}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if actual := SchemaFingerprint(desc); actual != expected {
+		delete(p.verified, key)
+		return &SchemaMismatchError{Database: database, Table: table, Expected: expected, Actual: actual}
+	}
+	p.verified[key] = true
+	return nil
+}
+
+// interceptor verifies a pinned table before the first call reading or
+// writing its rows in a database, and forgets the verification once the
+// client changes the table's schema. A check that cannot reach the server
+// lets the call through to fail or be journaled on its own, and is retried on
+// the next call.
+func (p *schemaPins) interceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	tr, ok := req.(tableRequest)
+	if !ok {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	table := tr.GetTableName()
+	_, database := connectionStringParts(tr.GetConnectionString())
+	if _, pinned := p.expected(database, table); !pinned {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	key := schemaPinKey{database: database, table: table}
+	if schemaMethods[method] {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err == nil {
+			p.mu.Lock()
+			delete(p.verified, key)
+			p.mu.Unlock()
+		}
+		return err
+	}
+	if method == proto.DatabaseService_QueryData_FullMethodName || writeMethods[method] {
+		p.mu.Lock()
+		verified := p.verified[key]
+		p.mu.Unlock()
+		if !verified {
+			desc, err := proto.NewDatabaseServiceClient(cc).DescribeTable(ctx, &proto.DescribeTableRequest{
+				TableName:        table,
+				ConnectionString: tr.GetConnectionString(),
+			})
+			if err == nil {
+				if err := p.check(database, table, desc); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
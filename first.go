@@ -0,0 +1,111 @@
+package godb
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ErrNoRows is returned by First, Last, and One when no row matches.
+var ErrNoRows = errors.New("no rows in result")
+
+// ErrMultipleRows is returned by One when more than one row matches.
+var ErrMultipleRows = errors.New("more than one row in result")
+
+// First scans the first matching row into dst, a pointer to a struct or to a
+// map[string]string, in the query's order. Without one it orders by the
+// cursor column, or by id. It returns ErrNoRows if nothing matches. The
+// builder's own limit is ignored.
+func (qb *QueryBuilder) First(dst interface{}) error {
+	return qb.scanOne("First", dst, qb.defaultOrder(), 1)
+}
+
+// Last scans the last matching row into dst, reversing the order First uses.
+// It returns ErrNoRows if nothing matches.
+func (qb *QueryBuilder) Last(dst interface{}) error {
+	return qb.scanOne("Last", dst, reverseOrder(qb.defaultOrder()), 1)
+}
+
+// One scans the only matching row into dst. It returns ErrNoRows if nothing
+// matches and ErrMultipleRows if more than one row does.
+func (qb *QueryBuilder) One(dst interface{}) error {
+	return qb.scanOne("One", dst, qb.orderBy, 2)
+}
+
+// defaultOrder returns the query's ORDER BY or, without one, the cursor's
+// order or id ascending.
+func (qb *QueryBuilder) defaultOrder() string {
+	switch {
+	case qb.orderBy != "":
+		return qb.orderBy
+	case qb.after != nil:
+		return qb.after.order()
+	}
+	return "id ASC"
+}
+
+// scanOne runs a copy of the query with orderBy and limit and decodes the first
+// row into dst.
+func (qb *QueryBuilder) scanOne(op string, dst interface{}, orderBy string, limit int) (err error) {
+	defer wrapOpError(&err, op, qb.tableName, "", time.Now())
+	defer qb.client.recoverInto(&err)
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("destination must be a non-nil pointer, got %T", dst)
+	}
+	q := *qb
+	// Pin the cursor to the query's own order before replacing it, so that
+	// Last still reads the rows after the cursor.
+	pos, err := q.resolveCursor()
+	if err != nil {
+		return err
+	}
+	if pos != nil {
+		q.conds = append(q.conds[:len(q.conds):len(q.conds)], rawCond(pos.condition()))
+		q.after, q.afterValue = nil, nil
+	}
+	q.orderBy = orderBy
+	q.limit = limit
+	resp, err := q.Exec()
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(resp.Rows) == 0:
+		return ErrNoRows
+	case len(resp.Rows) > 1 && op == "One":
+		return ErrMultipleRows
+	}
+	return decodeRow(resp.Rows[0], rv.Elem())
+}
+
+// reverseOrder flips the direction of each term of an ORDER BY clause. A term
+// without ASC or DESC gets DESC; NULLS FIRST and NULLS LAST are kept.
+func reverseOrder(orderBy string) string {
+	terms := strings.Split(orderBy, ",")
+	for i, term := range terms {
+		fields := strings.Fields(term)
+		if len(fields) == 0 {
+			continue
+		}
+		dir := len(fields)
+		for j, f := range fields {
+			if strings.EqualFold(f, "NULLS") {
+				dir = j
+				break
+			}
+		}
+		switch {
+		case dir > 1 && strings.EqualFold(fields[dir-1], "DESC"):
+			fields[dir-1] = "ASC"
+		case dir > 1 && strings.EqualFold(fields[dir-1], "ASC"):
+			fields[dir-1] = "DESC"
+		default:
+			fields = append(fields[:dir], append([]string{"DESC"}, fields[dir:]...)...)
+		}
+		terms[i] = strings.Join(fields, " ")
+	}
+	return strings.Join(terms, ", ")
+}
@@ -0,0 +1,98 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// Actions taken on referencing rows when the referenced row is deleted.
+const (
+	OnDeleteNoAction   = proto.ReferentialAction_REFERENTIAL_ACTION_NO_ACTION
+	OnDeleteRestrict   = proto.ReferentialAction_REFERENTIAL_ACTION_RESTRICT
+	OnDeleteCascade    = proto.ReferentialAction_REFERENTIAL_ACTION_CASCADE
+	OnDeleteSetNull    = proto.ReferentialAction_REFERENTIAL_ACTION_SET_NULL
+	OnDeleteSetDefault = proto.ReferentialAction_REFERENTIAL_ACTION_SET_DEFAULT
+)
+
+// References makes the column a foreign key to refTable.refColumn.
+func References(refTable, refColumn string, onDelete proto.ReferentialAction) ColumnOption {
+	return func(d *ColumnDef) {
+		d.RefTable = refTable
+		d.RefColumn = refColumn
+		d.OnDelete = onDelete
+	}
+}
+
+// AddForeignKey makes table.column reference refTable.refColumn, using the
+// client's stored connection string.
+func (c *GoDBClient) AddForeignKey(ctx context.Context, table, column, refTable, refColumn string, onDelete proto.ReferentialAction) (string, error) {
+	return c.addForeignKey(ctx, c.connectionStringFor(ctx), table, column, refTable, refColumn, onDelete)
+}
+
+// ListForeignKeys lists the foreign keys declared on table, or on every table
+// when table is empty.
+func (c *GoDBClient) ListForeignKeys(ctx context.Context, table string) ([]*proto.ForeignKey, error) {
+	return c.listForeignKeys(ctx, c.connectionStringFor(ctx), table)
+}
+
+// AddForeignKey makes column reference refTable.refColumn.
+func (t *Table) AddForeignKey(ctx context.Context, column, refTable, refColumn string, onDelete proto.ReferentialAction) (string, error) {
+	return t.client.addForeignKey(ctx, t.connString(ctx), t.name, column, refTable, refColumn, onDelete)
+}
+
+// ForeignKeys lists the foreign keys declared on the table.
+func (t *Table) ForeignKeys(ctx context.Context) ([]*proto.ForeignKey, error) {
+	return t.client.listForeignKeys(ctx, t.connString(ctx), t.name)
+}
+
+// addForeignKey calls AddForeignKey with connStr.
+func (c *GoDBClient) addForeignKey(ctx context.Context, connStr, table, column, refTable, refColumn string, onDelete proto.ReferentialAction) (_ string, err error) {
+	defer wrapOpError(&err, "AddForeignKey", table, proto.DatabaseService_AddForeignKey_FullMethodName, time.Now())
+	if column == "" || refTable == "" || refColumn == "" {
+		return "", fmt.Errorf("column, referenced table and referenced column are required")
+	}
+	req := &proto.AddForeignKeyRequest{
+		TableName:        table,
+		ColumnName:       column,
+		RefTable:         refTable,
+		RefColumn:        refColumn,
+		OnDelete:         onDelete,
+		ConnectionString: connStr,
+	}
+	resp, err := c.client.AddForeignKey(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Message, nil
+}
+
+// listForeignKeys calls ListForeignKeys with connStr.
+func (c *GoDBClient) listForeignKeys(ctx context.Context, connStr, table string) (_ []*proto.ForeignKey, err error) {
+	defer wrapOpError(&err, "ListForeignKeys", table, proto.DatabaseService_ListForeignKeys_FullMethodName, time.Now())
+	resp, err := c.client.ListForeignKeys(ctx, &proto.ListForeignKeysRequest{
+		TableName:        table,
+		ConnectionString: connStr,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.ForeignKeys, nil
+}
+
+// referentialActionSQL renders an action for a column declaration.
+func referentialActionSQL(a proto.ReferentialAction) string {
+	switch a {
+	case OnDeleteRestrict:
+		return "RESTRICT"
+	case OnDeleteCascade:
+		return "CASCADE"
+	case OnDeleteSetNull:
+		return "SET NULL"
+	case OnDeleteSetDefault:
+		return "SET DEFAULT"
+	}
+	return "NO ACTION"
+}
@@ -0,0 +1,21 @@
+module github.com/prakhar-5447/GoDB_SDK_GO/godbgorm
+
+go 1.24.0
+
+require (
+	github.com/prakhar-5447/GoDB_SDK_GO v1.1.0
+	gorm.io/gorm v1.31.2
+)
+
+require (
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	golang.org/x/net v0.32.0 // indirect
+	golang.org/x/sys v0.28.0 // indirect
+	golang.org/x/text v0.21.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20241202173237-19429a94021a // indirect
+	google.golang.org/grpc v1.70.0 // indirect
+	google.golang.org/protobuf v1.36.5 // indirect
+)
+
+replace github.com/prakhar-5447/GoDB_SDK_GO => ../
@@ -0,0 +1,440 @@
+package godbgorm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+)
+
+// Migrator changes the schema with SDK calls. Methods it does not override
+// fall back to GORM's SQL migrator and so to godbsql's schema statements.
+type Migrator struct {
+	migrator.Migrator
+	client *godb.GoDBClient
+}
+
+// context returns the context of the gorm.DB the migrator runs on.
+func (m Migrator) context() context.Context {
+	if m.DB.Statement != nil && m.DB.Statement.Context != nil {
+		return m.DB.Statement.Context
+	}
+	return context.Background()
+}
+
+// AutoMigrate creates missing tables, then adds missing columns, foreign keys,
+// and indexes to existing ones. Like godb.AutoMigrate it never changes or
+// drops what exists.
+func (m Migrator) AutoMigrate(values ...interface{}) error {
+	for _, value := range m.ReorderModels(values, true) {
+		if !m.HasTable(value) {
+			if err := m.CreateTable(value); err != nil {
+				return err
+			}
+			continue
+		}
+		err := m.RunWithValue(value, func(stmt *gorm.Statement) error {
+			desc, err := m.client.Table(stmt.Table).Describe(m.context())
+			if err != nil {
+				return err
+			}
+			existing := make(map[string]bool, len(desc.Columns))
+			for _, col := range desc.Columns {
+				existing[col.Name] = true
+			}
+			for _, name := range stmt.Schema.DBNames {
+				if field := stmt.Schema.FieldsByDBName[name]; !existing[name] && !field.IgnoreMigration {
+					if err := m.AddColumn(value, name); err != nil {
+						return err
+					}
+				}
+			}
+			for _, c := range m.constraints(stmt) {
+				if !m.HasConstraint(value, c.Name) {
+					if err := m.CreateConstraint(value, c.Name); err != nil {
+						return err
+					}
+				}
+			}
+			for _, idx := range stmt.Schema.ParseIndexes() {
+				if !m.HasIndex(value, idx.Name) {
+					if err := m.CreateIndex(value, idx.Name); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// constraints returns the single-column foreign keys stmt's table declares,
+// unless the gorm.DB disables them.
+func (m Migrator) constraints(stmt *gorm.Statement) []*schema.Constraint {
+	if m.DB.DisableForeignKeyConstraintWhenMigrating || m.DB.IgnoreRelationshipsWhenMigrating {
+		return nil
+	}
+	var out []*schema.Constraint
+	for _, rel := range stmt.Schema.Relationships.Relations {
+		if rel.Field.IgnoreMigration {
+			continue
+		}
+		if c := rel.ParseConstraint(); c != nil && c.Schema == stmt.Schema && len(c.ForeignKeys) == 1 {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// onDelete maps a constraint's OnDelete option to a referential action.
+func onDelete(c *schema.Constraint) proto.ReferentialAction {
+	switch strings.ToUpper(strings.TrimSpace(c.OnDelete)) {
+	case "RESTRICT":
+		return godb.OnDeleteRestrict
+	case "CASCADE":
+		return godb.OnDeleteCascade
+	case "SET NULL":
+		return godb.OnDeleteSetNull
+	case "SET DEFAULT":
+		return godb.OnDeleteSetDefault
+	}
+	return godb.OnDeleteNoAction
+}
+
+// columnDef converts a field to a column definition.
+func (m Migrator) columnDef(field *schema.Field) godb.ColumnDef {
+	def := godb.ColumnDef{
+		Name:       field.DBName,
+		Type:       godb.ColumnType(m.DataTypeOf(field)),
+		PrimaryKey: field.PrimaryKey,
+		NotNull:    field.NotNull,
+		Unique:     field.Unique,
+	}
+	if field.AutoIncrement {
+		def.AutoID = godb.AutoIDSequence
+	} else if field.HasDefaultValue && field.DefaultValueInterface != nil {
+		def.Default = field.DefaultValueInterface
+	}
+	return def
+}
+
+// CreateTable creates the tables of the models with their columns, foreign
+// keys, and indexes.
+func (m Migrator) CreateTable(values ...interface{}) error {
+	for _, value := range m.ReorderModels(values, false) {
+		err := m.RunWithValue(value, func(stmt *gorm.Statement) error {
+			defs := make(map[string]*godb.ColumnDef, len(stmt.Schema.DBNames))
+			var order []string
+			for _, name := range stmt.Schema.DBNames {
+				if field := stmt.Schema.FieldsByDBName[name]; !field.IgnoreMigration {
+					def := m.columnDef(field)
+					defs[name] = &def
+					order = append(order, name)
+				}
+			}
+			for _, c := range m.constraints(stmt) {
+				if def := defs[c.ForeignKeys[0].DBName]; def != nil && len(c.References) == 1 {
+					def.RefTable = c.ReferenceSchema.Table
+					def.RefColumn = c.References[0].DBName
+					def.OnDelete = onDelete(c)
+				}
+			}
+			ctb := m.client.NewTable(m.context()).Table(stmt.Table)
+			for _, name := range order {
+				ctb.Columns(*defs[name])
+			}
+			if _, err := ctb.Exec(); err != nil {
+				return err
+			}
+			for _, idx := range stmt.Schema.ParseIndexes() {
+				if err := m.CreateIndex(value, idx.Name); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DropTable fails: GoDB cannot drop tables.
+func (m Migrator) DropTable(values ...interface{}) error {
+	return fmt.Errorf("godbgorm: GoDB cannot drop tables: %w", gorm.ErrNotImplemented)
+}
+
+// RenameTable fails: GoDB cannot rename tables.
+func (m Migrator) RenameTable(oldName, newName interface{}) error {
+	return fmt.Errorf("godbgorm: GoDB cannot rename tables: %w", gorm.ErrNotImplemented)
+}
+
+// HasTable reports whether the model's table exists.
+func (m Migrator) HasTable(value interface{}) bool {
+	var exists bool
+	m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		desc, err := m.client.Table(stmt.Table).Describe(m.context())
+		exists = err == nil && desc.Exists
+		return err
+	})
+	return exists
+}
+
+// GetTables lists the tables of the database.
+func (m Migrator) GetTables() ([]string, error) {
+	return m.client.ListTables(m.context())
+}
+
+// CurrentDatabase returns the name of the client's database.
+func (m Migrator) CurrentDatabase() string {
+	return m.client.DatabaseFromConnectionString(m.client.ConnectionString()).Name()
+}
+
+// field looks up a field of stmt's model by Go or column name, returning the
+// column name as is when the model has no such field.
+func field(stmt *gorm.Statement, name string) (string, *schema.Field) {
+	if stmt.Schema != nil {
+		if f := stmt.Schema.LookUpField(name); f != nil {
+			return f.DBName, f
+		}
+	}
+	return name, nil
+}
+
+// AddColumn adds the model's field as a column.
+func (m Migrator) AddColumn(value interface{}, name string) error {
+	return m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		_, f := field(stmt, name)
+		if f == nil {
+			return fmt.Errorf("godbgorm: failed to look up field with name: %s", name)
+		}
+		_, err := m.client.Table(stmt.Table).Alter(m.context()).AddColumn(f.DBName, m.DataTypeOf(f)).Exec()
+		return err
+	})
+}
+
+// DropColumn drops a column.
+func (m Migrator) DropColumn(value interface{}, name string) error {
+	return m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		column, _ := field(stmt, name)
+		_, err := m.client.Table(stmt.Table).Alter(m.context()).DropColumn(column).Exec()
+		return err
+	})
+}
+
+// AlterColumn changes a column's type to the field's.
+func (m Migrator) AlterColumn(value interface{}, name string) error {
+	return m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		_, f := field(stmt, name)
+		if f == nil {
+			return fmt.Errorf("godbgorm: failed to look up field with name: %s", name)
+		}
+		_, err := m.client.Table(stmt.Table).Alter(m.context()).ModifyColumn(f.DBName, m.DataTypeOf(f)).Exec()
+		return err
+	})
+}
+
+// RenameColumn renames a column.
+func (m Migrator) RenameColumn(value interface{}, oldName, newName string) error {
+	return m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		oldColumn, _ := field(stmt, oldName)
+		newColumn, _ := field(stmt, newName)
+		_, err := m.client.Table(stmt.Table).Alter(m.context()).RenameColumn(oldColumn, newColumn).Exec()
+		return err
+	})
+}
+
+// HasColumn reports whether the table has the column.
+func (m Migrator) HasColumn(value interface{}, name string) bool {
+	columns, err := m.ColumnTypes(value)
+	if err != nil {
+		return false
+	}
+	var column string
+	m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		column, _ = field(stmt, name)
+		return nil
+	})
+	for _, c := range columns {
+		if c.Name() == column {
+			return true
+		}
+	}
+	return false
+}
+
+// ColumnTypes describes the table's columns.
+func (m Migrator) ColumnTypes(value interface{}) ([]gorm.ColumnType, error) {
+	var columns []gorm.ColumnType
+	err := m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		desc, err := m.client.Table(stmt.Table).Describe(m.context())
+		if err != nil {
+			return err
+		}
+		for _, col := range desc.Columns {
+			columns = append(columns, migrator.ColumnType{
+				NameValue:       sql.NullString{String: col.Name, Valid: true},
+				DataTypeValue:   sql.NullString{String: col.Type, Valid: true},
+				ColumnTypeValue: sql.NullString{String: col.Type, Valid: true},
+				NullableValue:   sql.NullBool{Bool: col.Nullable, Valid: true},
+			})
+		}
+		return nil
+	})
+	return columns, err
+}
+
+// CreateConstraint adds the foreign key the model declares under name.
+func (m Migrator) CreateConstraint(value interface{}, name string) error {
+	return m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		c, table := m.foreignKey(stmt, name)
+		if c == nil {
+			return fmt.Errorf("godbgorm: no single-column foreign key named %s", name)
+		}
+		_, err := m.client.Table(table).AddForeignKey(m.context(), c.ForeignKeys[0].DBName, c.ReferenceSchema.Table, c.References[0].DBName, onDelete(c))
+		return err
+	})
+}
+
+// HasConstraint reports whether the foreign key the model declares under
+// name exists. The server names foreign keys itself, so they are matched by
+// their columns.
+func (m Migrator) HasConstraint(value interface{}, name string) bool {
+	var found bool
+	m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		c, table := m.foreignKey(stmt, name)
+		if c == nil {
+			return nil
+		}
+		fks, err := m.client.Table(table).ForeignKeys(m.context())
+		if err != nil {
+			return err
+		}
+		for _, fk := range fks {
+			if fk.ColumnName == c.ForeignKeys[0].DBName && fk.RefTable == c.ReferenceSchema.Table && fk.RefColumn == c.References[0].DBName {
+				found = true
+			}
+		}
+		return nil
+	})
+	return found
+}
+
+// DropConstraint fails: GoDB cannot drop foreign keys.
+func (m Migrator) DropConstraint(value interface{}, name string) error {
+	return fmt.Errorf("godbgorm: GoDB cannot drop foreign keys: %w", gorm.ErrNotImplemented)
+}
+
+// foreignKey returns the single-column foreign key named name and the table
+// holding it.
+func (m Migrator) foreignKey(stmt *gorm.Statement, name string) (*schema.Constraint, string) {
+	c, _, table := m.GuessConstraintAndTable(stmt, name)
+	if c == nil || len(c.ForeignKeys) != 1 || len(c.References) != 1 {
+		return nil, table
+	}
+	return c, table
+}
+
+// CreateIndex creates the index the model declares under name, or on the
+// field name.
+func (m Migrator) CreateIndex(value interface{}, name string) error {
+	return m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		idx := stmt.Schema.LookIndex(name)
+		if idx == nil {
+			return fmt.Errorf("godbgorm: failed to create index with name %s", name)
+		}
+		columns := make([]string, 0, len(idx.Fields))
+		for _, f := range idx.Fields {
+			columns = append(columns, f.DBName)
+		}
+		t := m.client.Table(stmt.Table)
+		var err error
+		if idx.Class == "UNIQUE" {
+			_, err = t.AddUniqueIndex(m.context(), idx.Name, columns...)
+		} else {
+			_, err = t.AddIndex(m.context(), idx.Name, columns...)
+		}
+		return err
+	})
+}
+
+// indexName returns the name of the index the model declares under name, or
+// name itself.
+func indexName(stmt *gorm.Statement, name string) string {
+	if stmt.Schema != nil {
+		if idx := stmt.Schema.LookIndex(name); idx != nil {
+			return idx.Name
+		}
+	}
+	return name
+}
+
+// DropIndex drops an index.
+func (m Migrator) DropIndex(value interface{}, name string) error {
+	return m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		_, err := m.client.Table(stmt.Table).DropIndex(m.context(), indexName(stmt, name))
+		return err
+	})
+}
+
+// HasIndex reports whether the table has the index.
+func (m Migrator) HasIndex(value interface{}, name string) bool {
+	indexes, err := m.GetIndexes(value)
+	if err != nil {
+		return false
+	}
+	var want string
+	m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		want = indexName(stmt, name)
+		return nil
+	})
+	for _, idx := range indexes {
+		if idx.Name() == want {
+			return true
+		}
+	}
+	return false
+}
+
+// RenameIndex fails: GoDB cannot rename indexes.
+func (m Migrator) RenameIndex(value interface{}, oldName, newName string) error {
+	return fmt.Errorf("godbgorm: GoDB cannot rename indexes: %w", gorm.ErrNotImplemented)
+}
+
+// GetIndexes lists the table's indexes.
+func (m Migrator) GetIndexes(value interface{}) ([]gorm.Index, error) {
+	var indexes []gorm.Index
+	err := m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		list, err := m.client.Table(stmt.Table).Indexes(m.context())
+		if err != nil {
+			return err
+		}
+		for _, idx := range list {
+			var columns []string
+			for _, col := range strings.Split(idx.Columns, ",") {
+				if col = strings.TrimSpace(col); col != "" {
+					columns = append(columns, col)
+				}
+			}
+			indexes = append(indexes, &migrator.Index{
+				TableName:   idx.TableName,
+				NameValue:   idx.IndexName,
+				ColumnList:  columns,
+				UniqueValue: sql.NullBool{Bool: idx.Unique, Valid: true},
+			})
+		}
+		return nil
+	})
+	return indexes, err
+}
@@ -0,0 +1,94 @@
+// Package godbprom registers a GoDB client's metrics with a Prometheus
+// registry:
+//
+//	prometheus.MustRegister(godbprom.NewCollector(client.Collector()))
+//
+// It is a module of its own so that the SDK does not depend on the Prometheus
+// client library; MetricsCollector.ServeHTTP serves the same metrics without
+// it. To register the metrics of several clients with one registry, label
+// them apart with prometheus.WrapRegistererWith.
+package godbprom
+
+import (
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	durationDesc = prometheus.NewDesc("godb_request_duration_seconds",
+		"Latency of GoDB RPCs.", []string{"method"}, nil)
+	errorsDesc = prometheus.NewDesc("godb_request_errors_total",
+		"Failed GoDB RPCs by gRPC status code.", []string{"method", "code"}, nil)
+	retriesDesc = prometheus.NewDesc("godb_request_retries_total",
+		"Retried GoDB RPCs.", []string{"method"}, nil)
+	inFlightDesc = prometheus.NewDesc("godb_requests_in_flight",
+		"GoDB RPCs currently in progress.", nil, nil)
+	connectionFailuresDesc = prometheus.NewDesc("godb_connection_failures_total",
+		"Failed attempts to connect to the GoDB server.", nil, nil)
+	connectionStateDesc = prometheus.NewDesc("godb_connection_state",
+		"Current connection state (1 for the active state).", []string{"state"}, nil)
+	poolConnectionsDesc = prometheus.NewDesc("godb_pool_connections",
+		"Open connections in the client's pool.", nil, nil)
+	poolActiveStreamsDesc = prometheus.NewDesc("godb_pool_active_streams",
+		"Calls and streams in progress over the pool.", nil, nil)
+	poolMaxStreamsDesc = prometheus.NewDesc("godb_pool_max_streams_per_connection",
+		"Cap on active streams per pooled connection.", nil, nil)
+	poolUtilizationDesc = prometheus.NewDesc("godb_pool_utilization",
+		"Share of the pool's stream capacity in use.", nil, nil)
+)
+
+// Collector is a prometheus.Collector reporting the metrics of a
+// godb.MetricsCollector under the names its WriteTo uses.
+type Collector struct {
+	metrics *godb.MetricsCollector
+}
+
+// NewCollector returns a Collector for metrics, typically client.Collector().
+func NewCollector(metrics *godb.MetricsCollector) *Collector {
+	return &Collector{metrics: metrics}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		durationDesc, errorsDesc, retriesDesc, inFlightDesc,
+		connectionFailuresDesc, connectionStateDesc,
+		poolConnectionsDesc, poolActiveStreamsDesc, poolMaxStreamsDesc, poolUtilizationDesc,
+	} {
+		ch <- d
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	snap := c.metrics.Snapshot()
+	bounds := godb.LatencyBuckets()
+	for method, mm := range snap.Methods {
+		buckets := make(map[float64]uint64, len(bounds))
+		for i, le := range bounds {
+			buckets[le] = mm.Buckets[i]
+		}
+		ch <- prometheus.MustNewConstHistogram(durationDesc, mm.Count, mm.Sum, buckets, method)
+		for code, n := range mm.Errors {
+			ch <- prometheus.MustNewConstMetric(errorsDesc, prometheus.CounterValue, float64(n), method, code)
+		}
+	}
+	for method, n := range snap.Retries {
+		ch <- prometheus.MustNewConstMetric(retriesDesc, prometheus.CounterValue, float64(n), method)
+	}
+	ch <- prometheus.MustNewConstMetric(inFlightDesc, prometheus.GaugeValue, float64(snap.InFlight))
+	ch <- prometheus.MustNewConstMetric(connectionFailuresDesc, prometheus.CounterValue, float64(snap.ConnectionFailures))
+	for s := godb.StateIdle; s <= godb.StateShutdown; s++ {
+		v := 0.0
+		if s == snap.State {
+			v = 1
+		}
+		ch <- prometheus.MustNewConstMetric(connectionStateDesc, prometheus.GaugeValue, v, s.String())
+	}
+	if p := snap.Pool; p != nil {
+		ch <- prometheus.MustNewConstMetric(poolConnectionsDesc, prometheus.GaugeValue, float64(p.Connections))
+		ch <- prometheus.MustNewConstMetric(poolActiveStreamsDesc, prometheus.GaugeValue, float64(p.ActiveStreams))
+		ch <- prometheus.MustNewConstMetric(poolMaxStreamsDesc, prometheus.GaugeValue, float64(p.MaxStreams))
+		ch <- prometheus.MustNewConstMetric(poolUtilizationDesc, prometheus.GaugeValue, p.Utilization())
+	}
+}
@@ -0,0 +1,111 @@
+package godbsql
+
+import (
+	"database/sql/driver"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// timeLayouts are the layouts tried when a TIMESTAMP, DATETIME, or DATE
+// column arrives as a string.
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02"}
+
+// rows iterates over the rows of a result.
+type rows struct {
+	columns []string
+	types   map[string]string
+	data    []*proto.QueryRow
+}
+
+var _ driver.RowsColumnTypeDatabaseTypeName = (*rows)(nil)
+
+// newRows returns the rows of a result. The columns are the selected ones,
+// else those the server described, else the keys of the first row.
+func newRows(selected []string, info []*proto.ColumnInfo, data []*proto.QueryRow) *rows {
+	r := &rows{columns: selected, types: make(map[string]string), data: data}
+	for _, col := range info {
+		r.types[col.Name] = strings.ToUpper(col.Type)
+	}
+	if len(r.columns) == 0 {
+		for _, col := range info {
+			r.columns = append(r.columns, col.Name)
+		}
+	}
+	if len(r.columns) == 0 && len(data) > 0 {
+		for col := range godb.RowValues(data[0]) {
+			r.columns = append(r.columns, col)
+		}
+		sort.Strings(r.columns)
+	}
+	return r
+}
+
+func (r *rows) Columns() []string {
+	return r.columns
+}
+
+func (r *rows) Close() error {
+	r.data = nil
+	return nil
+}
+
+// Next converts the next row's values: typed values keep their Go type, and
+// strings are converted by the column's declared type. Missing columns are
+// NULL.
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.data) == 0 {
+		return io.EOF
+	}
+	values := godb.RowValues(r.data[0])
+	r.data = r.data[1:]
+	for i, col := range r.columns {
+		v, ok := values[col]
+		if !ok || v == godb.Null {
+			dest[i] = nil
+			continue
+		}
+		if s, isString := v.(string); isString {
+			v = convert(s, r.types[col])
+		}
+		dest[i] = v
+	}
+	return nil
+}
+
+// ColumnTypeDatabaseTypeName returns the column's declared type, or "" when
+// the server did not describe it.
+func (r *rows) ColumnTypeDatabaseTypeName(index int) string {
+	return r.types[r.columns[index]]
+}
+
+// convert converts the string form of a value of type typ, leaving it a
+// string when it does not parse.
+func convert(s, typ string) driver.Value {
+	switch typ {
+	case "INTEGER", "INT", "BIGINT":
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return n
+		}
+	case "REAL", "FLOAT", "DOUBLE":
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return f
+		}
+	case "BOOLEAN", "BOOL":
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b
+		}
+	case "TIMESTAMP", "DATETIME", "DATE":
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t
+			}
+		}
+	}
+	return s
+}
@@ -0,0 +1,596 @@
+package godbsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+	"github.com/prakhar-5447/GoDB_SDK_GO/ast"
+	"github.com/prakhar-5447/GoDB_SDK_GO/migrate"
+)
+
+// statement is a statement whose placeholders have been rewritten to $N,
+// where N is the 1-based position of the argument.
+type statement struct {
+	verb string
+	text string
+	args []interface{}
+}
+
+// parseStatement rewrites the placeholders of query: ? takes the next
+// argument, $N the Nth, and :name the named one.
+func parseStatement(query string, args []driver.NamedValue) (*statement, error) {
+	s := &statement{args: make([]interface{}, len(args))}
+	named := make(map[string]int)
+	for i, a := range args {
+		s.args[i] = a.Value
+		if a.Name != "" {
+			named[a.Name] = i + 1
+		}
+	}
+	var b strings.Builder
+	next := 0
+	var quote byte
+	for i := 0; i < len(query); i++ {
+		ch := query[i]
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"' || ch == '`':
+			quote = ch
+		case ch == '?':
+			next++
+			if next > len(args) {
+				return nil, fmt.Errorf("godbsql: statement has more placeholders than the %d arguments", len(args))
+			}
+			fmt.Fprintf(&b, "$%d", next)
+			continue
+		case ch == '$' && i+1 < len(query) && isDigit(query[i+1]):
+			j := i + 1
+			for j < len(query) && isDigit(query[j]) {
+				j++
+			}
+			n, _ := strconv.Atoi(query[i+1 : j])
+			if n < 1 || n > len(args) {
+				return nil, fmt.Errorf("godbsql: placeholder $%d has no argument", n)
+			}
+			b.WriteString(query[i:j])
+			i = j - 1
+			continue
+		case ch == ':' && i+1 < len(query) && query[i+1] == ':':
+			b.WriteString("::")
+			i++
+			continue
+		case ch == ':' && i+1 < len(query) && isNameStart(query[i+1]):
+			j := i + 1
+			for j < len(query) && (isNameStart(query[j]) || isDigit(query[j])) {
+				j++
+			}
+			n, ok := named[query[i+1:j]]
+			if !ok {
+				return nil, fmt.Errorf("godbsql: no argument named %s", query[i+1:j])
+			}
+			fmt.Fprintf(&b, "$%d", n)
+			i = j - 1
+			continue
+		}
+		b.WriteByte(ch)
+	}
+	s.text = strings.TrimSuffix(strings.TrimSpace(b.String()), ";")
+	if fields := strings.Fields(s.text); len(fields) > 0 {
+		s.verb = strings.ToUpper(fields[0])
+	}
+	return s, nil
+}
+
+func isDigit(ch byte) bool {
+	return '0' <= ch && ch <= '9'
+}
+
+func isNameStart(ch byte) bool {
+	return ch == '_' || ('a' <= ch && ch <= 'z') || ('A' <= ch && ch <= 'Z')
+}
+
+// bind replaces the $N placeholders of text with literals of their arguments.
+func (s *statement) bind(text string) (string, error) {
+	var b strings.Builder
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		ch := text[i]
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"' || ch == '`':
+			quote = ch
+		case ch == '$' && i+1 < len(text) && isDigit(text[i+1]):
+			j := i + 1
+			for j < len(text) && isDigit(text[j]) {
+				j++
+			}
+			n, _ := strconv.Atoi(text[i+1 : j])
+			v := s.args[n-1]
+			if bs, ok := v.([]byte); ok {
+				v = string(bs)
+			}
+			literal, err := godb.Literal(v)
+			if err != nil {
+				return "", fmt.Errorf("godbsql: argument $%d: %w", n, err)
+			}
+			b.WriteString(literal)
+			i = j - 1
+			continue
+		}
+		b.WriteByte(ch)
+	}
+	return b.String(), nil
+}
+
+// value returns the value of an item of VALUES or SET, which is a placeholder
+// or a literal.
+func (s *statement) value(item string) (interface{}, error) {
+	item = strings.TrimSpace(item)
+	if len(item) > 1 && item[0] == '$' {
+		if n, err := strconv.Atoi(item[1:]); err == nil {
+			return s.args[n-1], nil
+		}
+	}
+	e, err := ast.ParseExpr(item)
+	if err != nil {
+		return nil, err
+	}
+	lit, ok := e.(*ast.Literal)
+	if !ok {
+		return nil, fmt.Errorf("godbsql: %q is not a placeholder or literal", item)
+	}
+	switch lit.Kind {
+	case ast.Null:
+		return nil, nil
+	case ast.Bool:
+		return strings.EqualFold(lit.Value, "true"), nil
+	case ast.Number:
+		if n, err := strconv.ParseInt(lit.Value, 10, 64); err == nil {
+			return n, nil
+		}
+		return strconv.ParseFloat(lit.Value, 64)
+	}
+	return lit.Value, nil
+}
+
+func (s *statement) exec(ctx context.Context, client *godb.GoDBClient) (driver.Result, error) {
+	switch s.verb {
+	case "SELECT":
+		return nil, errors.New("godbsql: use Query for SELECT statements")
+	case "INSERT", "UPDATE", "DELETE":
+		res, _, err := s.write(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		return result{res}, nil
+	}
+	text, err := s.bind(s.text)
+	if err != nil {
+		return nil, err
+	}
+	script, err := migrate.ParseScript(text)
+	if err != nil {
+		return nil, fmt.Errorf("godbsql: %w", err)
+	}
+	if err := script(ctx, client); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (s *statement) query(ctx context.Context, client *godb.GoDBClient) (driver.Rows, error) {
+	switch s.verb {
+	case "SELECT":
+		text, err := s.bind(s.text)
+		if err != nil {
+			return nil, err
+		}
+		q, err := ast.Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("godbsql: %w", err)
+		}
+		if q.Table == "" {
+			return nil, errors.New("godbsql: SELECT needs a FROM clause")
+		}
+		q.Table = unquoteName(q.Table)
+		resp, err := client.QueryFromAST(ctx, q).Exec()
+		if err != nil {
+			return nil, err
+		}
+		return newRows(q.Columns, resp.Columns, resp.Rows), nil
+	case "INSERT", "UPDATE", "DELETE":
+		res, returning, err := s.write(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		if len(returning) == 0 {
+			return nil, fmt.Errorf("godbsql: %s without RETURNING has no rows; use Exec", s.verb)
+		}
+		return newRows(returning, nil, res.Returned), nil
+	}
+	return nil, fmt.Errorf("godbsql: %s statements return no rows; use Exec", s.verb)
+}
+
+// write runs an INSERT, UPDATE, or DELETE statement, returning the columns of
+// its RETURNING clause.
+func (s *statement) write(ctx context.Context, client *godb.GoDBClient) (godb.Result, []string, error) {
+	text, returning := s.text, []string(nil)
+	if i := findKeyword(text, "RETURNING"); i >= 0 {
+		returning = splitNames(text[i+len("RETURNING"):])
+		text = text[:i]
+	}
+	var res godb.Result
+	var err error
+	switch s.verb {
+	case "INSERT":
+		res, err = s.insert(ctx, client, text, returning)
+	case "UPDATE":
+		res, err = s.update(ctx, client, text, returning)
+	case "DELETE":
+		res, err = s.delete(ctx, client, text, returning)
+	}
+	return res, returning, err
+}
+
+// insert runs INSERT INTO t (cols) VALUES (...)[, (...)] [ON CONFLICT ...].
+func (s *statement) insert(ctx context.Context, client *godb.GoDBClient, text string, returning []string) (godb.Result, error) {
+	fields := strings.Fields(text)
+	if len(fields) < 3 || !strings.EqualFold(fields[1], "INTO") {
+		return godb.Result{}, errors.New("godbsql: expected INSERT INTO table (columns) VALUES (...)")
+	}
+	rest := strings.TrimSpace(text[strings.Index(strings.ToUpper(text), "INTO")+len("INTO"):])
+	open := strings.IndexByte(rest, '(')
+	v := findKeyword(rest, "VALUES")
+	if open < 0 || v < 0 || open > v {
+		return godb.Result{}, errors.New("godbsql: INSERT needs a column list and VALUES")
+	}
+	table := unquoteName(strings.TrimSpace(rest[:open]))
+	columns := splitNames(strings.TrimSuffix(strings.TrimSpace(rest[open+1:v]), ")"))
+	values := rest[v+len("VALUES"):]
+	var upsert *conflict
+	if on := findKeyword(values, "ON"); on >= 0 {
+		var err error
+		if upsert, err = parseConflict(values[on+len("ON"):]); err != nil {
+			return godb.Result{}, err
+		}
+		values = values[:on]
+	}
+	var rows [][]interface{}
+	for _, group := range splitTopLevel(values, ',') {
+		group = strings.TrimSpace(group)
+		if len(group) < 2 || group[0] != '(' || group[len(group)-1] != ')' {
+			return godb.Result{}, fmt.Errorf("godbsql: malformed VALUES list %q", group)
+		}
+		items := splitTopLevel(group[1:len(group)-1], ',')
+		if len(items) != len(columns) {
+			return godb.Result{}, fmt.Errorf("godbsql: %d values for %d columns", len(items), len(columns))
+		}
+		row := make([]interface{}, len(items))
+		for i, item := range items {
+			if strings.EqualFold(strings.TrimSpace(item), "DEFAULT") {
+				row[i] = columnDefault{}
+				continue
+			}
+			var err error
+			if row[i], err = s.value(item); err != nil {
+				return godb.Result{}, err
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	if upsert != nil {
+		return upsert.exec(ctx, client, table, columns, rows, returning)
+	}
+	if len(rows) == 1 {
+		return insertRow(ctx, client, table, columns, rows[0], returning)
+	}
+	imb := client.InsertMultiple(ctx).Table(table).BatchSize(0)
+	for _, row := range rows {
+		values := make(map[string]interface{}, len(columns))
+		for i, col := range columns {
+			if row[i] != (columnDefault{}) {
+				values[col] = row[i]
+			}
+		}
+		imb.Row(values)
+	}
+	if len(returning) > 0 {
+		imb.Returning(returning...)
+	}
+	return imb.ExecResult()
+}
+
+// columnDefault is a VALUES item written DEFAULT. The column is left out of
+// that row, so the server fills in its default.
+type columnDefault struct{}
+
+// insertRow inserts one row with typed values.
+func insertRow(ctx context.Context, client *godb.GoDBClient, table string, columns []string, row []interface{}, returning []string) (godb.Result, error) {
+	ib := client.Insert(ctx).Table(table)
+	for i, col := range columns {
+		if row[i] != (columnDefault{}) {
+			ib.Set(col, row[i])
+		}
+	}
+	if len(returning) > 0 {
+		ib.Returning(returning...)
+	}
+	return ib.ExecResult()
+}
+
+// conflict is the ON CONFLICT clause of an INSERT. The server's upsert
+// overwrites columns with the inserted values, so DO UPDATE may only set
+// columns to excluded.col.
+type conflict struct {
+	columns   []string
+	doNothing bool
+	update    []string
+}
+
+// parseConflict parses the clause following ON:
+//
+//	CONFLICT [(cols)] DO NOTHING
+//	CONFLICT [(cols)] DO UPDATE SET col = excluded.col[, ...]
+func parseConflict(text string) (*conflict, error) {
+	text = strings.TrimSpace(text)
+	if len(text) < len("CONFLICT") || !strings.EqualFold(text[:len("CONFLICT")], "CONFLICT") {
+		return nil, errors.New("godbsql: expected ON CONFLICT")
+	}
+	text = strings.TrimSpace(text[len("CONFLICT"):])
+	c := &conflict{}
+	if strings.HasPrefix(text, "(") {
+		end := strings.IndexByte(text, ')')
+		if end < 0 {
+			return nil, errors.New("godbsql: unterminated ON CONFLICT column list")
+		}
+		c.columns = splitNames(text[1:end])
+		text = strings.TrimSpace(text[end+1:])
+	}
+	fields := strings.Fields(text)
+	switch {
+	case len(fields) == 2 && strings.EqualFold(fields[0], "DO") && strings.EqualFold(fields[1], "NOTHING"):
+		c.doNothing = true
+	case len(fields) > 3 && strings.EqualFold(fields[0], "DO") && strings.EqualFold(fields[1], "UPDATE") && strings.EqualFold(fields[2], "SET"):
+		for _, a := range splitTopLevel(text[findKeyword(text, "SET")+len("SET"):], ',') {
+			eq := strings.IndexByte(a, '=')
+			if eq < 0 {
+				return nil, fmt.Errorf("godbsql: malformed assignment %q", strings.TrimSpace(a))
+			}
+			col := unquoteName(strings.TrimSpace(a[:eq]))
+			if !strings.EqualFold(strings.TrimSpace(a[eq+1:]), "excluded."+col) {
+				return nil, fmt.Errorf("godbsql: ON CONFLICT can only set %s to excluded.%s", col, col)
+			}
+			c.update = append(c.update, col)
+		}
+	default:
+		return nil, errors.New("godbsql: expected DO NOTHING or DO UPDATE SET after ON CONFLICT")
+	}
+	return c, nil
+}
+
+// exec upserts each row in turn. A row that lacks a conflict column cannot
+// conflict, so it is inserted.
+func (c *conflict) exec(ctx context.Context, client *godb.GoDBClient, table string, columns []string, rows [][]interface{}, returning []string) (godb.Result, error) {
+	covered := len(c.columns) > 0
+	for _, col := range c.columns {
+		covered = covered && containsName(columns, col)
+	}
+	var total godb.Result
+	for _, row := range rows {
+		var res godb.Result
+		var err error
+		if covered {
+			ub := client.Upsert(ctx).Table(table).OnConflict(c.columns...)
+			for i, col := range columns {
+				if row[i] != (columnDefault{}) {
+					ub.Set(col, row[i])
+				}
+			}
+			if c.doNothing {
+				ub.DoNothing()
+			} else {
+				ub.DoUpdate(c.update...)
+			}
+			if len(returning) > 0 {
+				ub.Returning(returning...)
+			}
+			res, err = ub.ExecResult()
+		} else {
+			res, err = insertRow(ctx, client, table, columns, row, returning)
+		}
+		if err != nil {
+			return total, err
+		}
+		total.RowsAffected += res.RowsAffected
+		total.InsertedIDs = append(total.InsertedIDs, res.InsertedIDs...)
+		total.Returned = append(total.Returned, res.Returned...)
+		total.Message = res.Message
+	}
+	return total, nil
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// update runs UPDATE t SET col = value[, ...] [WHERE ...].
+func (s *statement) update(ctx context.Context, client *godb.GoDBClient, text string, returning []string) (godb.Result, error) {
+	set := findKeyword(text, "SET")
+	if set < 0 {
+		return godb.Result{}, errors.New("godbsql: expected UPDATE table SET ...")
+	}
+	table := unquoteName(strings.TrimSpace(text[len("UPDATE"):set]))
+	assignments, where := text[set+len("SET"):], ""
+	if w := findKeyword(assignments, "WHERE"); w >= 0 {
+		assignments, where = assignments[:w], assignments[w+len("WHERE"):]
+	}
+	ub := client.UpdateRecord(ctx).Table(table)
+	for _, a := range splitTopLevel(assignments, ',') {
+		eq := strings.IndexByte(a, '=')
+		if eq < 0 {
+			return godb.Result{}, fmt.Errorf("godbsql: malformed assignment %q", strings.TrimSpace(a))
+		}
+		v, err := s.value(a[eq+1:])
+		if err != nil {
+			return godb.Result{}, err
+		}
+		ub.SetUpdate(unquoteName(strings.TrimSpace(a[:eq])), v)
+	}
+	if strings.TrimSpace(where) != "" {
+		cond, err := s.bind(where)
+		if err != nil {
+			return godb.Result{}, err
+		}
+		ub.Condition(strings.TrimSpace(cond))
+	}
+	if len(returning) > 0 {
+		ub.Returning(returning...)
+	}
+	return ub.ExecResult()
+}
+
+// delete runs DELETE FROM t [WHERE ...]; without WHERE it deletes every row.
+func (s *statement) delete(ctx context.Context, client *godb.GoDBClient, text string, returning []string) (godb.Result, error) {
+	fields := strings.Fields(text)
+	if len(fields) < 3 || !strings.EqualFold(fields[1], "FROM") {
+		return godb.Result{}, errors.New("godbsql: expected DELETE FROM table")
+	}
+	rest := strings.TrimSpace(text[strings.Index(strings.ToUpper(text), "FROM")+len("FROM"):])
+	table, where := rest, ""
+	if w := findKeyword(rest, "WHERE"); w >= 0 {
+		table, where = rest[:w], rest[w+len("WHERE"):]
+	}
+	db := client.Delete(ctx).Table(unquoteName(strings.TrimSpace(table)))
+	if strings.TrimSpace(where) == "" {
+		db.DeleteAll()
+	} else {
+		cond, err := s.bind(where)
+		if err != nil {
+			return godb.Result{}, err
+		}
+		db.Condition(strings.TrimSpace(cond))
+	}
+	if len(returning) > 0 {
+		db.Returning(returning...)
+	}
+	return db.ExecResult()
+}
+
+// findKeyword returns the offset of the first whole-word, case-insensitive
+// occurrence of kw in s outside quotes and parentheses, or -1.
+func findKeyword(s, kw string) int {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"' || ch == '`':
+			quote = ch
+		case ch == '(':
+			depth++
+		case ch == ')':
+			depth--
+		case depth == 0 && i+len(kw) <= len(s) && strings.EqualFold(s[i:i+len(kw)], kw):
+			before := i == 0 || !isWordByte(s[i-1])
+			after := i+len(kw) == len(s) || !isWordByte(s[i+len(kw)])
+			if before && after {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func isWordByte(ch byte) bool {
+	return isNameStart(ch) || isDigit(ch)
+}
+
+// splitTopLevel splits s on sep outside quotes and parentheses.
+func splitTopLevel(s string, sep byte) []string {
+	var parts []string
+	depth, start := 0, 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"' || ch == '`':
+			quote = ch
+		case ch == '(':
+			depth++
+		case ch == ')':
+			depth--
+		case ch == sep && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// splitNames splits a comma-separated list of column names.
+func splitNames(s string) []string {
+	var names []string
+	for _, name := range strings.Split(s, ",") {
+		if name = unquoteName(strings.TrimSpace(name)); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// unquoteName strips the double quotes or backquotes around an identifier.
+func unquoteName(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '`') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return strings.TrimFunc(s, unicode.IsSpace)
+}
+
+// result is the driver.Result of a write.
+type result struct {
+	godb.Result
+}
+
+// LastInsertId returns the last id the server reported, which must be an
+// integer.
+func (r result) LastInsertId() (int64, error) {
+	if len(r.InsertedIDs) == 0 {
+		return 0, errors.New("godbsql: the server reported no inserted id")
+	}
+	id := r.InsertedIDs[len(r.InsertedIDs)-1]
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("godbsql: inserted id %q is not an integer", id)
+	}
+	return n, nil
+}
+
+func (r result) RowsAffected() (int64, error) {
+	return r.Result.RowsAffected, nil
+}
@@ -0,0 +1,193 @@
+// Package container runs the GoDB server in Docker for integration tests,
+// through testcontainers-go. It is a separate module, which keeps
+// testcontainers-go out of the dependencies of the SDK and of godbtest:
+//
+//	client := container.Start(ctx, t)
+//
+// Start creates a throwaway user and database and returns a client connected
+// to them. Tests that can use the in-memory server should use godbtest
+// instead.
+package container
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// ImageEnv names the environment variable Start reads the GoDB server image
+// from when no Image option is given.
+const ImageEnv = "GODB_IMAGE"
+
+// options configure Start.
+type options struct {
+	image       string
+	port        string
+	startup     time.Duration
+	clientOpts  []godb.Option
+	customizers []testcontainers.ContainerCustomizer
+}
+
+// Option configures Start.
+type Option func(*options)
+
+// Image sets the GoDB server image to run.
+func Image(image string) Option {
+	return func(o *options) {
+		o.image = image
+	}
+}
+
+// Port sets the port the server listens on inside the container; the default
+// is 50051.
+func Port(port int) Option {
+	return func(o *options) {
+		o.port = fmt.Sprintf("%d/tcp", port)
+	}
+}
+
+// StartupTimeout bounds how long Start waits for the server to answer; the
+// default is one minute.
+func StartupTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.startup = d
+	}
+}
+
+// ClientOptions are applied to the returned client, after the connection
+// string Start sets.
+func ClientOptions(opts ...godb.Option) Option {
+	return func(o *options) {
+		o.clientOpts = append(o.clientOpts, opts...)
+	}
+}
+
+// Customize adds testcontainers-go customizers to the container request, e.g.
+// testcontainers.WithEnv.
+func Customize(customizers ...testcontainers.ContainerCustomizer) Option {
+	return func(o *options) {
+		o.customizers = append(o.customizers, customizers...)
+	}
+}
+
+// Start runs the GoDB server image, waits until it answers, creates a
+// throwaway user and database, and returns a client connected to them. The
+// container is terminated and the client closed when the test ends.
+//
+// The image comes from Image or the GODB_IMAGE environment variable; the test
+// is skipped when neither is set or Docker is not available, so integration
+// tests stay out of the way of plain go test runs.
+func Start(ctx context.Context, tb testing.TB, opts ...Option) *godb.GoDBClient {
+	tb.Helper()
+	o := options{image: os.Getenv(ImageEnv), port: "50051/tcp", startup: time.Minute}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.image == "" {
+		tb.Skipf("container: no GoDB image; set %s to run integration tests", ImageEnv)
+	}
+	skipWithoutDocker(ctx, tb)
+
+	customizers := append([]testcontainers.ContainerCustomizer{
+		testcontainers.WithExposedPorts(o.port),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort(o.port).WithStartupTimeout(o.startup)),
+	}, o.customizers...)
+	ctr, err := testcontainers.Run(ctx, o.image, customizers...)
+	testcontainers.CleanupContainer(tb, ctr)
+	if err != nil {
+		tb.Fatalf("container: start: %v", err)
+	}
+	addr, err := ctr.PortEndpoint(ctx, o.port, "")
+	if err != nil {
+		tb.Fatalf("container: find port: %v", err)
+	}
+
+	connStr, err := provision(ctx, addr, o.startup)
+	if err != nil {
+		tb.Fatalf("container: %v (container %s)", err, ctr.GetContainerID())
+	}
+	client, err := godb.NewGoDBClient(addr, append([]godb.Option{godb.WithConnectionString(connStr)}, o.clientOpts...)...)
+	if err != nil {
+		tb.Fatalf("container: %v", err)
+	}
+	tb.Cleanup(func() { client.Close() })
+	return client
+}
+
+// skipWithoutDocker skips the test when the Docker provider is missing or
+// unhealthy.
+func skipWithoutDocker(ctx context.Context, tb testing.TB) {
+	tb.Helper()
+	provider, err := testcontainers.ProviderDocker.GetProvider()
+	if err == nil {
+		defer provider.Close()
+		err = provider.Health(ctx)
+	}
+	if err != nil {
+		tb.Skipf("container: Docker is not available: %v", err)
+	}
+}
+
+// provision waits for the server at addr and creates a user and database,
+// returning the database's connection string.
+func provision(ctx context.Context, addr string, startup time.Duration) (string, error) {
+	boot, err := godb.NewGoDBClient(addr)
+	if err != nil {
+		return "", err
+	}
+	defer boot.Close()
+	if err := waitReady(ctx, boot, startup); err != nil {
+		return "", err
+	}
+
+	suffix := randomSuffix()
+	_, userConn, err := boot.CreateUser(ctx, "godbtest_"+suffix, randomSuffix()+randomSuffix())
+	if err != nil {
+		return "", err
+	}
+	admin, err := godb.NewGoDBClient(addr, godb.WithConnectionString(userConn))
+	if err != nil {
+		return "", err
+	}
+	defer admin.Close()
+	db := admin.Database("test_" + suffix)
+	if _, err := db.Create(ctx); err != nil {
+		return "", err
+	}
+	return db.ConnectionString(), nil
+}
+
+// waitReady polls the server until it answers Capabilities. A listening port
+// does not mean the gRPC service is up yet.
+func waitReady(ctx context.Context, client *godb.GoDBClient, startup time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, startup)
+	defer cancel()
+	for {
+		attempt, cancelAttempt := context.WithTimeout(ctx, time.Second)
+		_, err := client.Capabilities(attempt)
+		cancelAttempt()
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("server not ready after %s: %w", startup, err)
+		case <-time.After(250 * time.Millisecond):
+		}
+	}
+}
+
+// randomSuffix returns 8 random hex digits.
+func randomSuffix() string {
+	var b [4]byte
+	rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
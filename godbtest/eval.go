@@ -0,0 +1,303 @@
+package godbtest
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/ast"
+)
+
+// value is a column value or the result of an expression; NULL is null.
+type value struct {
+	s    string
+	null bool
+}
+
+var (
+	null       = value{null: true}
+	trueValue  = value{s: "1"}
+	falseValue = value{s: "0"}
+)
+
+// boolValue returns the value of a predicate.
+func boolValue(b bool) value {
+	if b {
+		return trueValue
+	}
+	return falseValue
+}
+
+// truthy reports whether v counts as true in a WHERE clause.
+func truthy(v value) bool {
+	if v.null {
+		return false
+	}
+	switch strings.ToLower(v.s) {
+	case "", "0", "false":
+		return false
+	}
+	if f, err := strconv.ParseFloat(v.s, 64); err == nil {
+		return f != 0
+	}
+	return true
+}
+
+// number parses v as a number.
+func number(v value) (float64, bool) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(v.s), 64)
+	return f, err == nil
+}
+
+// compare orders two non-NULL values, numerically when both are numbers and
+// as text otherwise.
+func compare(a, b value) int {
+	if x, ok := number(a); ok {
+		if y, ok := number(b); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(a.s, b.s)
+}
+
+// matches reports whether row satisfies where; a nil where matches every row.
+func matches(where ast.Expr, row map[string]string) (bool, error) {
+	if where == nil {
+		return true, nil
+	}
+	v, err := eval(where, row)
+	if err != nil {
+		return false, err
+	}
+	return truthy(v), nil
+}
+
+// eval evaluates e against row with SQL's NULL semantics.
+func eval(e ast.Expr, row map[string]string) (value, error) {
+	switch e := e.(type) {
+	case *ast.Ident:
+		name := e.Name
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			name = name[i+1:]
+		}
+		if v, ok := row[name]; ok {
+			return value{s: v}, nil
+		}
+		return null, nil
+	case *ast.Literal:
+		if e.Kind == ast.Null {
+			return null, nil
+		}
+		return value{s: e.Value}, nil
+	case *ast.Binary:
+		return evalBinary(e, row)
+	case *ast.And:
+		result := trueValue
+		for _, x := range e.Exprs {
+			v, err := eval(x, row)
+			if err != nil {
+				return null, err
+			}
+			if !v.null && !truthy(v) {
+				return falseValue, nil
+			}
+			if v.null {
+				result = null
+			}
+		}
+		return result, nil
+	case *ast.Or:
+		result := falseValue
+		for _, x := range e.Exprs {
+			v, err := eval(x, row)
+			if err != nil {
+				return null, err
+			}
+			if truthy(v) {
+				return trueValue, nil
+			}
+			if v.null {
+				result = null
+			}
+		}
+		return result, nil
+	case *ast.Not:
+		v, err := eval(e.Expr, row)
+		if err != nil || v.null {
+			return null, err
+		}
+		return boolValue(!truthy(v)), nil
+	case *ast.In:
+		x, err := eval(e.Expr, row)
+		if err != nil || x.null {
+			return null, err
+		}
+		result := falseValue
+		for _, ve := range e.Values {
+			v, err := eval(ve, row)
+			if err != nil {
+				return null, err
+			}
+			if v.null {
+				result = null
+			} else if compare(x, v) == 0 {
+				result = trueValue
+				break
+			}
+		}
+		if e.Not && !result.null {
+			return boolValue(!truthy(result)), nil
+		}
+		return result, nil
+	case *ast.Between:
+		x, err := eval(e.Expr, row)
+		if err != nil {
+			return null, err
+		}
+		lo, err := eval(e.Lo, row)
+		if err != nil {
+			return null, err
+		}
+		hi, err := eval(e.Hi, row)
+		if err != nil || x.null || lo.null || hi.null {
+			return null, err
+		}
+		in := compare(x, lo) >= 0 && compare(x, hi) <= 0
+		return boolValue(in != e.Not), nil
+	case *ast.IsNull:
+		x, err := eval(e.Expr, row)
+		if err != nil {
+			return null, err
+		}
+		return boolValue(x.null != e.Not), nil
+	case *ast.Call:
+		return evalCall(e, row)
+	}
+	return null, fmt.Errorf("unsupported expression %T", e)
+}
+
+func evalBinary(e *ast.Binary, row map[string]string) (value, error) {
+	l, err := eval(e.Left, row)
+	if err != nil {
+		return null, err
+	}
+	r, err := eval(e.Right, row)
+	if err != nil {
+		return null, err
+	}
+	if l.null || r.null {
+		return null, nil
+	}
+	switch e.Op {
+	case "=":
+		return boolValue(compare(l, r) == 0), nil
+	case "!=", "<>":
+		return boolValue(compare(l, r) != 0), nil
+	case "<":
+		return boolValue(compare(l, r) < 0), nil
+	case "<=":
+		return boolValue(compare(l, r) <= 0), nil
+	case ">":
+		return boolValue(compare(l, r) > 0), nil
+	case ">=":
+		return boolValue(compare(l, r) >= 0), nil
+	case "LIKE", "NOT LIKE":
+		re, err := likePattern(r.s)
+		if err != nil {
+			return null, err
+		}
+		return boolValue(re.MatchString(l.s) == (e.Op == "LIKE")), nil
+	case "||":
+		return value{s: l.s + r.s}, nil
+	}
+	x, okx := number(l)
+	y, oky := number(r)
+	if !okx || !oky {
+		return null, fmt.Errorf("%s needs numbers, got %q and %q", e.Op, l.s, r.s)
+	}
+	var f float64
+	switch e.Op {
+	case "+":
+		f = x + y
+	case "-":
+		f = x - y
+	case "*":
+		f = x * y
+	case "/":
+		if y == 0 {
+			return null, nil
+		}
+		f = x / y
+	case "%":
+		if y == 0 {
+			return null, nil
+		}
+		f = math.Mod(x, y)
+	default:
+		return null, fmt.Errorf("unsupported operator %s", e.Op)
+	}
+	return value{s: strconv.FormatFloat(f, 'f', -1, 64)}, nil
+}
+
+// evalCall evaluates the scalar functions the mock supports.
+func evalCall(e *ast.Call, row map[string]string) (value, error) {
+	args := make([]value, len(e.Args))
+	for i, a := range e.Args {
+		v, err := eval(a, row)
+		if err != nil {
+			return null, err
+		}
+		args[i] = v
+	}
+	name := strings.ToLower(e.Name)
+	if name == "coalesce" {
+		for _, a := range args {
+			if !a.null {
+				return a, nil
+			}
+		}
+		return null, nil
+	}
+	if len(args) != 1 {
+		return null, fmt.Errorf("unsupported function %s with %d arguments", e.Name, len(args))
+	}
+	if args[0].null {
+		return null, nil
+	}
+	switch name {
+	case "lower":
+		return value{s: strings.ToLower(args[0].s)}, nil
+	case "upper":
+		return value{s: strings.ToUpper(args[0].s)}, nil
+	case "length":
+		return value{s: strconv.Itoa(len([]rune(args[0].s)))}, nil
+	}
+	return null, fmt.Errorf("unsupported function %s", e.Name)
+}
+
+// likePattern compiles a LIKE pattern, which matches ASCII letters without
+// regard to case as SQLite does.
+func likePattern(p string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("(?is)^")
+	for _, r := range p {
+		switch r {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
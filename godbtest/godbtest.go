@@ -0,0 +1,141 @@
+// Package godbtest provides an in-memory GoDB server for application tests,
+// so they need neither the Docker container nor hand-written gRPC fakes.
+//
+// New returns a MockClient, which is a real *godb.GoDBClient (builders,
+// options, and interceptors included) connected to a server that keeps its
+// tables in memory:
+//
+//	func TestSignup(t *testing.T) {
+//		client := godbtest.New(t)
+//		client.NewTable(ctx).Table("users").
+//			Column("id", godb.Int, godb.PrimaryKey()).
+//			Column("email", godb.Text, godb.Unique()).
+//			Exec()
+//		signup(ctx, client.GoDBClient, "ann@example.com")
+//		if rows := client.Rows("users"); len(rows) != 1 {
+//			t.Fatalf("got %d users, want 1", len(rows))
+//		}
+//	}
+//
+// The server understands the condition language of the ast package: WHERE
+// with comparisons, AND/OR/NOT, IN, BETWEEN, IS NULL, LIKE, and the functions
+// coalesce, lower, upper, and length, plus ORDER BY, LIMIT, OFFSET, and a
+// lone count(*) column. Queries with GROUP BY or HAVING, other aggregates, and
+// RPCs it does not implement, fail with
+// codes.Unimplemented. Transactions work on a copy of the database that
+// Commit installs, failing with codes.Aborted if the database changed outside
+// the transaction meanwhile, and queries that want a checksum get one. It enforces PRIMARY KEY, UNIQUE, unique indexes, and
+// NOT NULL, fills integer primary keys and defaults, and compares values
+// numerically when both sides are numbers and as text otherwise.
+//
+// Integration tests that need the real server use the separate
+// godbtest/container module instead, which runs the GoDB image in Docker
+// through testcontainers-go and returns a client connected to a throwaway
+// database:
+//
+//	client := container.Start(ctx, t)
+package godbtest
+
+import (
+	"context"
+	"net"
+	"sort"
+	"testing"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// DefaultConnectionString is the connection string of a MockClient unless
+// godb.WithConnectionString overrides it.
+const DefaultConnectionString = "grpc://godbtest/test"
+
+// MockClient is a GoDB client backed by an in-memory server.
+type MockClient struct {
+	*godb.GoDBClient
+
+	srv  *server
+	grpc *grpc.Server
+	lis  *bufconn.Listener
+}
+
+// New starts an in-memory server and returns a client connected to it. The
+// options are applied after the connection string and dialer New sets, so a
+// test may pick another database with godb.WithConnectionString. The client is
+// closed when the test ends.
+func New(tb testing.TB, opts ...godb.Option) *MockClient {
+	tb.Helper()
+	m, err := NewMockClient(opts...)
+	if err != nil {
+		tb.Fatalf("godbtest: %v", err)
+	}
+	tb.Cleanup(func() { m.Close() })
+	return m
+}
+
+// NewMockClient is New for code without a testing.TB; the caller must Close
+// the client.
+func NewMockClient(opts ...godb.Option) (*MockClient, error) {
+	srv := newServer()
+	m := &MockClient{
+		srv:  srv,
+		grpc: grpc.NewServer(grpc.UnaryInterceptor(srv.checkTx)),
+		lis:  bufconn.Listen(1 << 20),
+	}
+	proto.RegisterDatabaseServiceServer(m.grpc, m.srv)
+	go m.grpc.Serve(m.lis)
+
+	dial := func(ctx context.Context, _ string) (net.Conn, error) {
+		return m.lis.DialContext(ctx)
+	}
+	opts = append([]godb.Option{
+		godb.WithConnectionString(DefaultConnectionString),
+		godb.WithDialOptions(grpc.WithContextDialer(dial)),
+	}, opts...)
+	client, err := godb.NewGoDBClient("passthrough:///godbtest", opts...)
+	if err != nil {
+		m.grpc.Stop()
+		return nil, err
+	}
+	m.GoDBClient = client
+	return m, nil
+}
+
+// Close closes the client and stops the server.
+func (m *MockClient) Close() error {
+	err := m.GoDBClient.Close()
+	m.grpc.Stop()
+	return err
+}
+
+// Rows returns a copy of the rows of the client's table, in insertion order,
+// for assertions. NULL columns are absent from their row. It returns nil for
+// a table that does not exist.
+func (m *MockClient) Rows(table string) []map[string]string {
+	m.srv.mu.Lock()
+	defer m.srv.mu.Unlock()
+	t, ok := m.srv.dbs[dbName(m.ConnectionString())][table]
+	if !ok {
+		return nil
+	}
+	rows := make([]map[string]string, len(t.rows))
+	for i, row := range t.rows {
+		rows[i] = project(row, nil)
+	}
+	return rows
+}
+
+// Tables returns the names of the tables in the client's database, sorted.
+func (m *MockClient) Tables() []string {
+	m.srv.mu.Lock()
+	defer m.srv.mu.Unlock()
+	var names []string
+	for name := range m.srv.dbs[dbName(m.ConnectionString())] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,227 @@
+package godbtest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// RecordEnv names the environment variable that makes Golden record against
+// a live server instead of replaying.
+const RecordEnv = "GODB_RECORD"
+
+// exchange is one recorded call, stored as a line of JSON.
+type exchange struct {
+	Method   string          `json:"method"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Code     codes.Code      `json:"code,omitempty"`
+	Message  string          `json:"message,omitempty"`
+}
+
+// Record returns an option that writes every unary call the client makes, its
+// request and its response or error status, to the golden file at path. The
+// file is replaced on the first call. Credentials are masked as godb.Redact
+// masks them, so golden files can be checked in; Replay masks the requests it
+// matches the same way.
+func Record(path string) godb.Option {
+	r := &recorder{path: path}
+	return godb.WithUnaryInterceptors(r.interceptor)
+}
+
+// recorder appends exchanges to a golden file, opening it for each one so
+// that no file is left open when the client goes away.
+type recorder struct {
+	path string
+
+	mu      sync.Mutex
+	started bool
+}
+
+func (r *recorder) interceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	callErr := invoker(ctx, method, req, reply, cc, opts...)
+	e := exchange{Method: method}
+	var err error
+	if e.Request, err = marshalJSON(godb.Redact(req.(protobuf.Message))); err != nil {
+		return fmt.Errorf("godbtest: record %s: %w", method, err)
+	}
+	if callErr != nil {
+		st := status.Convert(callErr)
+		e.Code, e.Message = st.Code(), st.Message()
+	} else if e.Response, err = marshalJSON(godb.Redact(reply.(protobuf.Message))); err != nil {
+		return fmt.Errorf("godbtest: record %s: %w", method, err)
+	}
+	if err := r.write(e); err != nil {
+		return fmt.Errorf("godbtest: record %s: %w", method, err)
+	}
+	return callErr
+}
+
+func (r *recorder) write(e exchange) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	flag := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if !r.started {
+		if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+			return err
+		}
+		flag |= os.O_TRUNC
+	}
+	f, err := os.OpenFile(r.path, flag, 0o600)
+	if err != nil {
+		return err
+	}
+	r.started = true
+	_, err = f.Write(append(line, '\n'))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Replay returns a client that answers unary calls from the golden file at
+// path instead of a server. Each call is matched to an unused recorded call of
+// the same method with an equal request; a call with no match fails the test
+// and returns codes.FailedPrecondition. Streaming calls are not recorded and
+// fail with codes.Unavailable. The client is closed when the test ends.
+func Replay(tb testing.TB, path string, opts ...godb.Option) *godb.GoDBClient {
+	tb.Helper()
+	p, err := loadPlayer(path)
+	if err != nil {
+		tb.Fatalf("godbtest: %v", err)
+	}
+	p.tb = tb
+	client, err := godb.NewGoDBClient("passthrough:///godbtest-replay", append(opts, godb.WithUnaryInterceptors(p.interceptor))...)
+	if err != nil {
+		tb.Fatalf("godbtest: %v", err)
+	}
+	tb.Cleanup(func() { client.Close() })
+	return client
+}
+
+// Golden records the test's calls against the server at address into the
+// golden file at path when GODB_RECORD is set in the environment, and replays
+// them otherwise, so CI runs the same code paths without a live server:
+//
+//	client := godbtest.Golden(t, "testdata/checkout.rpc", "localhost:50051",
+//		godb.WithConnectionString(connStr))
+func Golden(tb testing.TB, path, address string, opts ...godb.Option) *godb.GoDBClient {
+	tb.Helper()
+	if os.Getenv(RecordEnv) == "" {
+		return Replay(tb, path, opts...)
+	}
+	client, err := godb.NewGoDBClient(address, append(opts, Record(path))...)
+	if err != nil {
+		tb.Fatalf("godbtest: %v", err)
+	}
+	tb.Cleanup(func() { client.Close() })
+	return client
+}
+
+// player serves recorded exchanges.
+type player struct {
+	path string
+	tb   testing.TB
+
+	mu        sync.Mutex
+	exchanges []exchange
+	used      []bool
+}
+
+func loadPlayer(path string) (*player, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open golden file: %w (set %s=1 to record it)", err, RecordEnv)
+	}
+	defer f.Close()
+	p := &player{path: path}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 64<<20)
+	for n := 1; scanner.Scan(); n++ {
+		var e exchange
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, n, err)
+		}
+		p.exchanges = append(p.exchanges, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	p.used = make([]bool, len(p.exchanges))
+	return p, nil
+}
+
+func (p *player) interceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	e, err := p.match(method, req)
+	if err != nil {
+		p.tb.Errorf("godbtest: %v", err)
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if e.Code != codes.OK {
+		return status.Error(e.Code, e.Message)
+	}
+	if err := protojson.Unmarshal(e.Response, reply.(protobuf.Message)); err != nil {
+		return fmt.Errorf("godbtest: replay %s: %w", method, err)
+	}
+	return nil
+}
+
+// match returns and marks used the first unused exchange for method whose
+// request equals req once its credentials are masked.
+func (p *player) match(method string, req interface{}) (exchange, error) {
+	msg := godb.Redact(req.(protobuf.Message))
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	seen := 0
+	for i, e := range p.exchanges {
+		if p.used[i] || e.Method != method {
+			continue
+		}
+		seen++
+		recorded := msg.ProtoReflect().New().Interface()
+		if err := protojson.Unmarshal(e.Request, recorded); err != nil {
+			return exchange{}, fmt.Errorf("%s: %s request: %w", p.path, method, err)
+		}
+		if protobuf.Equal(recorded, msg) {
+			p.used[i] = true
+			return e, nil
+		}
+	}
+	got, _ := marshalJSON(msg)
+	return exchange{}, fmt.Errorf("%s: no recorded %s call matches request %s (%d unused calls of that method)", p.path, method, got, seen)
+}
+
+// marshalJSON encodes a message as compact JSON; protojson's own spacing is
+// deliberately unstable, which would churn golden files.
+func marshalJSON(m interface{}) (json.RawMessage, error) {
+	b, err := protojson.Marshal(m.(protobuf.Message))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, b); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,930 @@
+package godbtest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/ast"
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// server is an in-memory DatabaseService. Databases are named by the last
+// path segment of the connection string and created on first use.
+type server struct {
+	proto.UnimplementedDatabaseServiceServer
+
+	mu  sync.Mutex
+	dbs map[string]map[string]*table
+	txs map[string]*tx
+}
+
+func newServer() *server {
+	return &server{
+		dbs: make(map[string]map[string]*table),
+		txs: make(map[string]*tx),
+	}
+}
+
+// column is a column definition.
+type column struct {
+	name       string
+	typ        string
+	primaryKey bool
+	notNull    bool
+	unique     bool
+	hasDefault bool
+	def        string
+	generated  proto.IdGeneration
+}
+
+// table holds a table's definition and rows. NULL columns are absent from
+// their row.
+type table struct {
+	columns []*column
+	byName  map[string]*column
+	rows    []map[string]string
+	seq     int64
+	indexes []*proto.Index
+}
+
+// dbName returns the database a connection string names.
+func dbName(connStr string) string {
+	if i := strings.LastIndex(connStr, "/"); i >= 0 {
+		return connStr[i+1:]
+	}
+	return connStr
+}
+
+// tables returns the tables of the database connStr names, creating it.
+// Calls in a transaction see the transaction's copy instead.
+func (s *server) tables(ctx context.Context, connStr string) map[string]*table {
+	name := dbName(connStr)
+	if tx := s.tx(ctx); tx != nil && tx.db == name {
+		return tx.tables
+	}
+	db, ok := s.dbs[name]
+	if !ok {
+		db = make(map[string]*table)
+		s.dbs[name] = db
+	}
+	return db
+}
+
+// table returns the named table or a NotFound error.
+func (s *server) table(ctx context.Context, connStr, name string) (*table, error) {
+	t, ok := s.tables(ctx, connStr)[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no such table: %s", name)
+	}
+	return t, nil
+}
+
+func (s *server) Capabilities(ctx context.Context, req *proto.CapabilitiesRequest) (*proto.CapabilitiesResponse, error) {
+	return &proto.CapabilitiesResponse{ServerVersion: "godbtest"}, nil
+}
+
+func (s *server) CreateDatabase(ctx context.Context, req *proto.CreateDatabaseRequest) (*proto.CreateDatabaseResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.dbs[dbName(req.ConnectionString)]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "database %s already exists", dbName(req.ConnectionString))
+	}
+	s.tables(ctx, req.ConnectionString)
+	return &proto.CreateDatabaseResponse{Message: "database created", ConnectionString: req.ConnectionString}, nil
+}
+
+func (s *server) DropDatabase(ctx context.Context, req *proto.DropDatabaseRequest) (*proto.DropDatabaseResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := dbName(req.ConnectionString)
+	db, ok := s.dbs[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no such database: %s", name)
+	}
+	if len(db) > 0 && !req.Force {
+		return nil, status.Errorf(codes.FailedPrecondition, "database %s has tables", name)
+	}
+	delete(s.dbs, name)
+	return &proto.DropDatabaseResponse{Message: "database dropped"}, nil
+}
+
+func (s *server) ListDatabases(ctx context.Context, req *proto.ListDatabasesRequest) (*proto.ListDatabasesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &proto.ListDatabasesResponse{}
+	for name := range s.dbs {
+		resp.Databases = append(resp.Databases, name)
+	}
+	sort.Strings(resp.Databases)
+	return resp, nil
+}
+
+func (s *server) ListTables(ctx context.Context, req *proto.ListTablesRequest) (*proto.ListTablesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &proto.ListTablesResponse{}
+	for name := range s.tables(ctx, req.ConnectionString) {
+		resp.Tables = append(resp.Tables, name)
+	}
+	sort.Strings(resp.Tables)
+	return resp, nil
+}
+
+func (s *server) CreateTable(ctx context.Context, req *proto.CreateTableRequest) (*proto.CreateTableResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	db := s.tables(ctx, req.ConnectionString)
+	if _, ok := db[req.TableName]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "table %s already exists", req.TableName)
+	}
+	t := &table{byName: make(map[string]*column)}
+	if len(req.ColumnDefs) > 0 {
+		for _, d := range req.ColumnDefs {
+			t.columns = append(t.columns, &column{
+				name:       d.Name,
+				typ:        strings.ToUpper(d.Type),
+				primaryKey: d.PrimaryKey,
+				notNull:    d.NotNull,
+				unique:     d.Unique,
+				hasDefault: d.HasDefault,
+				def:        unquote(d.DefaultValue),
+				generated:  d.GeneratedId,
+			})
+		}
+	} else {
+		names := make([]string, 0, len(req.Columns))
+		for name := range req.Columns {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			t.columns = append(t.columns, parseDeclaration(name, req.Columns[name]))
+		}
+	}
+	if len(t.columns) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "table has no columns")
+	}
+	for _, col := range t.columns {
+		if _, dup := t.byName[col.name]; dup {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate column %s", col.name)
+		}
+		t.byName[col.name] = col
+	}
+	db[req.TableName] = t
+	return &proto.CreateTableResponse{Message: "table created"}, nil
+}
+
+// parseDeclaration reads a column declaration such as "INTEGER PRIMARY KEY".
+func parseDeclaration(name, decl string) *column {
+	upper := strings.ToUpper(decl)
+	col := &column{name: name, typ: "TEXT"}
+	if fields := strings.Fields(upper); len(fields) > 0 {
+		col.typ = fields[0]
+	}
+	col.primaryKey = strings.Contains(upper, "PRIMARY KEY")
+	col.notNull = strings.Contains(upper, "NOT NULL")
+	col.unique = strings.Contains(upper, "UNIQUE")
+	if strings.Contains(upper, "AUTOINCREMENT") {
+		col.generated = proto.IdGeneration_ID_GENERATION_SEQUENCE
+	}
+	if i := strings.Index(upper, "DEFAULT "); i >= 0 {
+		col.hasDefault = true
+		col.def = unquote(strings.Fields(decl[i+len("DEFAULT "):])[0])
+	}
+	return col
+}
+
+// unquote strips the quotes of a string literal.
+func unquote(s string) string {
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+	}
+	return s
+}
+
+func (s *server) DescribeTable(ctx context.Context, req *proto.DescribeTableRequest) (*proto.DescribeTableResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tables(ctx, req.ConnectionString)[req.TableName]
+	if !ok {
+		return &proto.DescribeTableResponse{}, nil
+	}
+	return &proto.DescribeTableResponse{Exists: true, Columns: t.columnInfo(nil)}, nil
+}
+
+// columnInfo describes the named columns, or every column for nil.
+func (t *table) columnInfo(names []string) []*proto.ColumnInfo {
+	if names == nil {
+		for _, col := range t.columns {
+			names = append(names, col.name)
+		}
+	}
+	out := make([]*proto.ColumnInfo, len(names))
+	for i, name := range names {
+		col := t.byName[name]
+		out[i] = &proto.ColumnInfo{Name: name, Type: col.typ, Nullable: !col.notNull && !col.primaryKey}
+	}
+	return out
+}
+
+// record merges a request's string and typed values into a row.
+func record(data map[string]string, typed map[string]*proto.Value) map[string]string {
+	row := make(map[string]string, len(data))
+	for col, v := range data {
+		row[col] = v
+	}
+	for col, v := range typed {
+		if _, isNull := v.GetKind().(*proto.Value_NullValue); isNull {
+			row[col] = nullMarker
+			continue
+		}
+		if _, ok := row[col]; !ok {
+			row[col] = valueString(v)
+		}
+	}
+	return row
+}
+
+// nullMarker marks a column set to NULL in a write.
+const nullMarker = "\x00NULL\x00"
+
+// valueString renders a typed value as its wire string.
+func valueString(v *proto.Value) string {
+	switch k := v.GetKind().(type) {
+	case *proto.Value_StringValue:
+		return k.StringValue
+	case *proto.Value_IntValue:
+		return strconv.FormatInt(k.IntValue, 10)
+	case *proto.Value_DoubleValue:
+		return strconv.FormatFloat(k.DoubleValue, 'g', -1, 64)
+	case *proto.Value_BoolValue:
+		return strconv.FormatBool(k.BoolValue)
+	case *proto.Value_BytesValue:
+		return base64.StdEncoding.EncodeToString(k.BytesValue)
+	case *proto.Value_TimestampMicros:
+		return time.UnixMicro(k.TimestampMicros).UTC().Format("2006-01-02T15:04:05.000000Z07:00")
+	}
+	return ""
+}
+
+// insert validates values and adds them as a new row, returning the row.
+func (t *table) insert(values map[string]string) (map[string]string, error) {
+	row := make(map[string]string, len(t.columns))
+	for col, v := range values {
+		if _, ok := t.byName[col]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "table has no column named %s", col)
+		}
+		if v != nullMarker {
+			row[col] = v
+		}
+	}
+	for _, col := range t.columns {
+		if _, set := values[col.name]; set {
+			continue
+		}
+		switch {
+		case col.generated == proto.IdGeneration_ID_GENERATION_UUID:
+			row[col.name] = newUUID()
+		case col.generated == proto.IdGeneration_ID_GENERATION_SEQUENCE || (col.primaryKey && col.typ == "INTEGER"):
+			t.seq++
+			row[col.name] = strconv.FormatInt(t.seq, 10)
+		case col.hasDefault && strings.ToUpper(col.def) != "NULL":
+			row[col.name] = col.def
+		}
+	}
+	if err := t.check(row, -1); err != nil {
+		return nil, err
+	}
+	t.noteID(row)
+	t.rows = append(t.rows, row)
+	return row, nil
+}
+
+// noteID keeps the sequence past explicitly inserted integer ids.
+func (t *table) noteID(row map[string]string) {
+	for _, col := range t.columns {
+		if col.generated == proto.IdGeneration_ID_GENERATION_SEQUENCE || (col.primaryKey && col.typ == "INTEGER") {
+			if n, err := strconv.ParseInt(row[col.name], 10, 64); err == nil && n > t.seq {
+				t.seq = n
+			}
+		}
+	}
+}
+
+// check enforces NOT NULL, PRIMARY KEY, and UNIQUE for row, which replaces the
+// row at index skip, or is new when skip is -1.
+func (t *table) check(row map[string]string, skip int) error {
+	for _, col := range t.columns {
+		v, set := row[col.name]
+		if !set {
+			if col.notNull || col.primaryKey {
+				return status.Errorf(codes.InvalidArgument, "NOT NULL constraint failed: %s", col.name)
+			}
+			continue
+		}
+		if !col.unique && !col.primaryKey {
+			continue
+		}
+		for i, other := range t.rows {
+			if w, ok := other[col.name]; ok && i != skip && w == v {
+				return status.Errorf(codes.AlreadyExists, "UNIQUE constraint failed: %s", col.name)
+			}
+		}
+	}
+	for _, idx := range t.indexes {
+		if !idx.Unique {
+			continue
+		}
+		columns := strings.Split(idx.Columns, ",")
+		for i, other := range t.rows {
+			if i != skip && sameValues(row, other, columns) {
+				return status.Errorf(codes.AlreadyExists, "UNIQUE constraint failed: %s", idx.IndexName)
+			}
+		}
+	}
+	return nil
+}
+
+// id returns the value identifying row: its primary key or id column.
+func (t *table) id(row map[string]string) string {
+	for _, col := range t.columns {
+		if col.primaryKey {
+			return row[col.name]
+		}
+	}
+	return row["id"]
+}
+
+// returned projects rows onto the returning columns.
+func returned(rows []map[string]string, columns []string) []*proto.QueryRow {
+	if len(columns) == 0 {
+		return nil
+	}
+	out := make([]*proto.QueryRow, len(rows))
+	for i, row := range rows {
+		out[i] = &proto.QueryRow{Data: project(row, columns)}
+	}
+	return out
+}
+
+// project copies the named columns of row, or all of them for nil.
+func project(row map[string]string, columns []string) map[string]string {
+	out := make(map[string]string, len(row))
+	for col, v := range row {
+		if columns == nil {
+			out[col] = v
+		}
+	}
+	for _, col := range columns {
+		if v, ok := row[col]; ok {
+			out[col] = v
+		}
+	}
+	return out
+}
+
+func (s *server) InsertRecord(ctx context.Context, req *proto.InsertRecordRequest) (*proto.InsertRecordResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(ctx, req.ConnectionString, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	row, err := t.insert(record(req.Record, req.TypedRecord))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.InsertRecordResponse{
+		Message:      "record inserted",
+		RowsAffected: 1,
+		InsertedId:   t.id(row),
+		Returned:     returned([]map[string]string{row}, req.Returning),
+	}, nil
+}
+
+func (s *server) InsertMultipleRecords(ctx context.Context, req *proto.InsertMultipleRecordsRequest) (*proto.InsertMultipleRecordsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(ctx, req.ConnectionString, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	// The request is atomic: a failing record leaves the table as it was.
+	saved, seq := append([]map[string]string(nil), t.rows...), t.seq
+	resp := &proto.InsertMultipleRecordsResponse{Message: "records inserted"}
+	var rows []map[string]string
+	for _, rec := range req.Records {
+		row, err := t.insert(record(rec.Data, rec.TypedData))
+		if err != nil {
+			t.rows, t.seq = saved, seq
+			return nil, err
+		}
+		rows = append(rows, row)
+		resp.InsertedIds = append(resp.InsertedIds, t.id(row))
+	}
+	resp.RowsAffected = int64(len(rows))
+	resp.Returned = returned(rows, req.Returning)
+	return resp, nil
+}
+
+func (s *server) UpsertRecord(ctx context.Context, req *proto.UpsertRecordRequest) (*proto.UpsertRecordResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(ctx, req.ConnectionString, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	values := record(req.Record, req.TypedRecord)
+	conflict := req.ConflictColumns
+	if len(conflict) == 0 {
+		for _, col := range t.columns {
+			if col.primaryKey {
+				conflict = append(conflict, col.name)
+			}
+		}
+	}
+	if len(conflict) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "upsert needs conflict columns or a primary key")
+	}
+	for i, row := range t.rows {
+		if !sameValues(row, values, conflict) {
+			continue
+		}
+		if req.DoNothing {
+			return &proto.UpsertRecordResponse{Message: "record exists"}, nil
+		}
+		update := req.UpdateColumns
+		if len(update) == 0 {
+			for col := range values {
+				if !contains(conflict, col) {
+					update = append(update, col)
+				}
+			}
+		}
+		next := project(row, nil)
+		for _, col := range update {
+			if v, ok := values[col]; ok && v != nullMarker {
+				next[col] = v
+			} else {
+				delete(next, col)
+			}
+		}
+		if err := t.check(next, i); err != nil {
+			return nil, err
+		}
+		t.rows[i] = next
+		return &proto.UpsertRecordResponse{
+			Message:      "record updated",
+			RowsAffected: 1,
+			Returned:     returned([]map[string]string{next}, req.Returning),
+		}, nil
+	}
+	row, err := t.insert(values)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.UpsertRecordResponse{
+		Message:      "record inserted",
+		RowsAffected: 1,
+		InsertedId:   t.id(row),
+		Returned:     returned([]map[string]string{row}, req.Returning),
+	}, nil
+}
+
+// sameValues reports whether a and b hold the same non-NULL values in columns.
+func sameValues(a, b map[string]string, columns []string) bool {
+	for _, col := range columns {
+		x, okx := a[col]
+		y, oky := b[col]
+		if !okx || !oky || y == nullMarker || x != y {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+// parseQuery parses a condition string, rejecting what the mock cannot run.
+func parseQuery(condition string) (*ast.Query, error) {
+	q, err := ast.Parse(condition)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(q.GroupBy) > 0 || q.Having != nil {
+		return nil, status.Error(codes.Unimplemented, "godbtest does not support GROUP BY")
+	}
+	return q, nil
+}
+
+// selectRows returns the indexes of the rows matching q's condition, ordered
+// and limited as q says.
+func (t *table) selectRows(q *ast.Query) ([]int, error) {
+	var idx []int
+	for i, row := range t.rows {
+		ok, err := matches(q.Where, row)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if ok {
+			idx = append(idx, i)
+		}
+	}
+	if len(q.OrderBy) > 0 {
+		sort.SliceStable(idx, func(a, b int) bool {
+			for _, term := range q.OrderBy {
+				x, okx := t.rows[idx[a]][term.Column]
+				y, oky := t.rows[idx[b]][term.Column]
+				var c int
+				switch {
+				case !okx && !oky:
+				case !okx:
+					c = -1
+				case !oky:
+					c = 1
+				default:
+					c = compare(value{s: x}, value{s: y})
+				}
+				if term.Desc {
+					c = -c
+				}
+				if c != 0 {
+					return c < 0
+				}
+			}
+			return false
+		})
+	}
+	if q.Offset > 0 {
+		if q.Offset >= len(idx) {
+			return nil, nil
+		}
+		idx = idx[q.Offset:]
+	}
+	if q.Limit > 0 && q.Limit < len(idx) {
+		idx = idx[:q.Limit]
+	}
+	return idx, nil
+}
+
+func (s *server) QueryData(ctx context.Context, req *proto.QueryDataRequest) (*proto.QueryDataResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(ctx, req.ConnectionString, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	q, err := parseQuery(req.Condition)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(strings.ReplaceAll(req.Columns, " ", ""), "count(*)") {
+		idx, err := t.selectRows(q)
+		if err != nil {
+			return nil, err
+		}
+		resp := &proto.QueryDataResponse{
+			Columns: []*proto.ColumnInfo{{Name: req.Columns, Type: "INTEGER"}},
+			Rows:    []*proto.QueryRow{{Data: map[string]string{req.Columns: strconv.Itoa(len(idx))}}},
+		}
+		if req.WantChecksum {
+			resp.Checksum = rowsChecksum(resp.Rows)
+		}
+		return resp, nil
+	}
+	var columns []string
+	if c := strings.TrimSpace(req.Columns); c != "" && c != "*" {
+		for _, col := range strings.Split(c, ",") {
+			col = strings.TrimSpace(col)
+			if _, ok := t.byName[col]; !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "no such column: %s", col)
+			}
+			columns = append(columns, col)
+		}
+	}
+	idx, err := t.selectRows(q)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.QueryDataResponse{Columns: t.columnInfo(columns)}
+	for _, i := range idx {
+		resp.Rows = append(resp.Rows, &proto.QueryRow{Data: project(t.rows[i], columns)})
+	}
+	if req.WantChecksum {
+		resp.Checksum = rowsChecksum(resp.Rows)
+	}
+	return resp, nil
+}
+
+// rowsChecksum returns the checksum QueryDataResponse.checksum describes: a
+// hex SHA-256 over the rows in result order, covering their data and their
+// typed values.
+func rowsChecksum(rows []*proto.QueryRow) string {
+	h := sha256.New()
+	for _, row := range rows {
+		keys := make([]string, 0, len(row.Data))
+		for k := range row.Data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(h, "%q=%q;", k, row.Data[k])
+		}
+		keys = keys[:0]
+		for k := range row.Values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			kind, value := checksumValue(row.Values[k])
+			fmt.Fprintf(h, "%q:%s=%q;", k, kind, value)
+		}
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// checksumValue returns the kind of a typed value, named as its field in
+// Value, and its string form for rowsChecksum.
+func checksumValue(v *proto.Value) (kind, value string) {
+	switch k := v.GetKind().(type) {
+	case *proto.Value_StringValue:
+		return "string_value", k.StringValue
+	case *proto.Value_IntValue:
+		return "int_value", strconv.FormatInt(k.IntValue, 10)
+	case *proto.Value_DoubleValue:
+		return "double_value", strconv.FormatFloat(k.DoubleValue, 'g', -1, 64)
+	case *proto.Value_BoolValue:
+		return "bool_value", strconv.FormatBool(k.BoolValue)
+	case *proto.Value_BytesValue:
+		return "bytes_value", base64.StdEncoding.EncodeToString(k.BytesValue)
+	case *proto.Value_TimestampMicros:
+		return "timestamp_micros", strconv.FormatInt(k.TimestampMicros, 10)
+	case *proto.Value_NullValue:
+		return "null_value", ""
+	}
+	return "", ""
+}
+
+func (s *server) UpdateRecord(ctx context.Context, req *proto.UpdateRecordRequest) (*proto.UpdateRecordResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(ctx, req.ConnectionString, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	q, err := parseQuery(req.Condition)
+	if err != nil {
+		return nil, err
+	}
+	updates := record(req.Updates, req.TypedUpdates)
+	for col := range updates {
+		if _, ok := t.byName[col]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "no such column: %s", col)
+		}
+	}
+	idx, err := t.selectRows(q)
+	if err != nil {
+		return nil, err
+	}
+	saved := append([]map[string]string(nil), t.rows...)
+	resp := &proto.UpdateRecordResponse{Message: "records updated", RowsAffected: int64(len(idx))}
+	var updated []map[string]string
+	for _, i := range idx {
+		before := t.rows[i]
+		after := project(before, nil)
+		for col, v := range updates {
+			if v == nullMarker {
+				delete(after, col)
+			} else {
+				after[col] = v
+			}
+		}
+		if err := t.check(after, i); err != nil {
+			t.rows = saved
+			return nil, err
+		}
+		t.rows[i] = after
+		updated = append(updated, after)
+		if len(resp.Changes) < int(req.ReturnChanges) {
+			resp.Changes = append(resp.Changes, &proto.RowChange{
+				Before: &proto.QueryRow{Data: project(before, nil)},
+				After:  &proto.QueryRow{Data: project(after, nil)},
+			})
+		} else if req.ReturnChanges > 0 {
+			resp.ChangesTruncated = true
+		}
+	}
+	resp.Returned = returned(updated, req.Returning)
+	return resp, nil
+}
+
+func (s *server) DeleteRecord(ctx context.Context, req *proto.DeleteRecordRequest) (*proto.DeleteRecordResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(ctx, req.ConnectionString, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	if req.Condition == "" && !req.DeleteAll {
+		return nil, status.Error(codes.InvalidArgument, "condition is required")
+	}
+	q, err := parseQuery(req.Condition)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := t.selectRows(q)
+	if err != nil {
+		return nil, err
+	}
+	drop := make(map[int]bool, len(idx))
+	var deleted []map[string]string
+	for _, i := range idx {
+		drop[i] = true
+		deleted = append(deleted, t.rows[i])
+	}
+	kept := t.rows[:0:0]
+	for i, row := range t.rows {
+		if !drop[i] {
+			kept = append(kept, row)
+		}
+	}
+	t.rows = kept
+	return &proto.DeleteRecordResponse{
+		Message:     "records deleted",
+		RowsDeleted: int64(len(deleted)),
+		Returned:    returned(deleted, req.Returning),
+	}, nil
+}
+
+func (s *server) TruncateTable(ctx context.Context, req *proto.TruncateTableRequest) (*proto.TruncateTableResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(ctx, req.ConnectionString, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	n := len(t.rows)
+	t.rows = nil
+	if req.ResetSequences {
+		t.seq = 0
+	}
+	return &proto.TruncateTableResponse{Message: "table truncated", RowsDeleted: int64(n)}, nil
+}
+
+func (s *server) CopyTable(ctx context.Context, req *proto.CopyTableRequest) (*proto.CopyTableResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	src, err := s.table(ctx, req.ConnectionString, req.SourceTable)
+	if err != nil {
+		return nil, err
+	}
+	db := s.tables(ctx, req.ConnectionString)
+	if _, ok := db[req.TableName]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "table %s already exists", req.TableName)
+	}
+	dst := src.clone(req.WithData)
+	db[req.TableName] = dst
+	return &proto.CopyTableResponse{Message: "table copied", RowsCopied: int64(len(dst.rows))}, nil
+}
+
+func (s *server) CloneDatabase(ctx context.Context, req *proto.CloneDatabaseRequest) (*proto.CloneDatabaseResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.dbs[req.NewDatabase]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "database %s already exists", req.NewDatabase)
+	}
+	clone := make(map[string]*table)
+	for name, t := range s.tables(ctx, req.ConnectionString) {
+		clone[name] = t.clone(true)
+	}
+	s.dbs[req.NewDatabase] = clone
+	connStr := req.NewDatabase
+	if i := strings.LastIndex(req.ConnectionString, "/"); i >= 0 {
+		connStr = req.ConnectionString[:i+1] + req.NewDatabase
+	}
+	return &proto.CloneDatabaseResponse{Message: "database cloned", ConnectionString: connStr}, nil
+}
+
+// clone copies the table's definition and, when withData is set, its rows.
+func (t *table) clone(withData bool) *table {
+	c := &table{columns: t.columns, byName: t.byName}
+	if withData {
+		c.seq = t.seq
+		for _, row := range t.rows {
+			c.rows = append(c.rows, project(row, nil))
+		}
+	}
+	return c
+}
+
+// newUUID returns a random version 4 UUID.
+func newUUID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// index returns the table and position of the named index.
+func (s *server) index(ctx context.Context, connStr, name string) (*table, int) {
+	for _, t := range s.tables(ctx, connStr) {
+		for i, idx := range t.indexes {
+			if idx.IndexName == name {
+				return t, i
+			}
+		}
+	}
+	return nil, -1
+}
+
+func (s *server) AddIndex(ctx context.Context, req *proto.AddIndexRequest) (*proto.AddIndexResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.table(ctx, req.ConnectionString, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	if other, _ := s.index(ctx, req.ConnectionString, req.IndexName); other != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "index %s already exists", req.IndexName)
+	}
+	if len(req.Columns) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "index has no columns")
+	}
+	for _, col := range req.Columns {
+		if t.byName[col] == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "no such column: %s", col)
+		}
+	}
+	idx := &proto.Index{
+		IndexName: req.IndexName,
+		TableName: req.TableName,
+		Columns:   strings.Join(req.Columns, ","),
+		Unique:    req.Unique,
+	}
+	if idx.Unique {
+		for i, row := range t.rows {
+			for _, other := range t.rows[i+1:] {
+				if sameValues(row, other, req.Columns) {
+					return nil, status.Errorf(codes.FailedPrecondition, "rows duplicate the columns of unique index %s", req.IndexName)
+				}
+			}
+		}
+	}
+	t.indexes = append(t.indexes, idx)
+	return &proto.AddIndexResponse{Message: "index created"}, nil
+}
+
+func (s *server) DeleteIndex(ctx context.Context, req *proto.DeleteIndexRequest) (*proto.DeleteIndexResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, i := s.index(ctx, req.ConnectionString, req.IndexName)
+	if t == nil {
+		return nil, status.Errorf(codes.NotFound, "no such index: %s", req.IndexName)
+	}
+	t.indexes = append(t.indexes[:i], t.indexes[i+1:]...)
+	return &proto.DeleteIndexResponse{Message: "index deleted"}, nil
+}
+
+func (s *server) ListIndexes(ctx context.Context, req *proto.ListIndexesRequest) (*proto.ListIndexesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	db := s.tables(ctx, req.ConnectionString)
+	names := make([]string, 0, len(db))
+	for name := range db {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	resp := &proto.ListIndexesResponse{}
+	for _, name := range names {
+		for _, idx := range db[name].indexes {
+			resp.Indexes = append(resp.Indexes, &proto.Index{
+				IndexName: idx.IndexName,
+				TableName: idx.TableName,
+				Columns:   idx.Columns,
+				Unique:    idx.Unique,
+			})
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,113 @@
+package godbtest
+
+import (
+	"context"
+	"path"
+	"reflect"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// txMetadataKey carries the transaction id of a call, as the godb client
+// sends it.
+const txMetadataKey = "godb-transaction-id"
+
+// tx is an open transaction. Its calls work on a copy of the database, which
+// Commit installs unless the database changed outside the transaction since
+// Begin.
+type tx struct {
+	db     string
+	base   map[string]*table // the database as Begin found it
+	tables map[string]*table // the transaction's copy
+}
+
+// txID returns the transaction id a call carries, or "".
+func txID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ids := md.Get(txMetadataKey); len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
+
+// tx returns the open transaction of a call, or nil.
+func (s *server) tx(ctx context.Context) *tx {
+	return s.txs[txID(ctx)]
+}
+
+// checkTx rejects calls that name a transaction that is not open or that
+// belongs to another database, and calls a transaction cannot contain.
+func (s *server) checkTx(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	id := txID(ctx)
+	r, ok := req.(interface{ GetConnectionString() string })
+	if id == "" || !ok {
+		return handler(ctx, req)
+	}
+	s.mu.Lock()
+	tx := s.txs[id]
+	s.mu.Unlock()
+	if tx == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no such transaction: %s", id)
+	}
+	if name := dbName(r.GetConnectionString()); name != tx.db {
+		return nil, status.Errorf(codes.FailedPrecondition, "transaction %s belongs to database %s, not %s", id, tx.db, name)
+	}
+	switch info.FullMethod {
+	case proto.DatabaseService_BeginTransaction_FullMethodName,
+		proto.DatabaseService_CreateDatabase_FullMethodName,
+		proto.DatabaseService_DropDatabase_FullMethodName,
+		proto.DatabaseService_CloneDatabase_FullMethodName:
+		return nil, status.Errorf(codes.Unimplemented, "godbtest does not support %s in a transaction", path.Base(info.FullMethod))
+	}
+	return handler(ctx, req)
+}
+
+// snapshot returns a copy of a database's tables, rows and indexes included.
+func snapshot(db map[string]*table) map[string]*table {
+	c := make(map[string]*table, len(db))
+	for name, t := range db {
+		ct := t.clone(true)
+		ct.indexes = append([]*proto.Index(nil), t.indexes...)
+		c[name] = ct
+	}
+	return c
+}
+
+func (s *server) BeginTransaction(ctx context.Context, req *proto.BeginTransactionRequest) (*proto.BeginTransactionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	db := s.tables(ctx, req.ConnectionString)
+	id := newUUID()
+	s.txs[id] = &tx{db: dbName(req.ConnectionString), base: snapshot(db), tables: snapshot(db)}
+	return &proto.BeginTransactionResponse{TransactionId: id}, nil
+}
+
+func (s *server) CommitTransaction(ctx context.Context, req *proto.CommitTransactionRequest) (*proto.CommitTransactionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tx, ok := s.txs[req.TransactionId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no such transaction: %s", req.TransactionId)
+	}
+	delete(s.txs, req.TransactionId)
+	if !reflect.DeepEqual(s.dbs[tx.db], tx.base) {
+		return nil, status.Error(codes.Aborted, "transaction conflicts with a write outside it")
+	}
+	s.dbs[tx.db] = tx.tables
+	return &proto.CommitTransactionResponse{Message: "transaction committed"}, nil
+}
+
+func (s *server) RollbackTransaction(ctx context.Context, req *proto.RollbackTransactionRequest) (*proto.RollbackTransactionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.txs[req.TransactionId]; !ok {
+		return nil, status.Errorf(codes.NotFound, "no such transaction: %s", req.TransactionId)
+	}
+	delete(s.txs, req.TransactionId)
+	return &proto.RollbackTransactionResponse{Message: "transaction rolled back"}, nil
+}
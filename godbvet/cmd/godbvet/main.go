@@ -0,0 +1,21 @@
+// Command godbvet reports risky uses of the GoDB SDK in Go packages.
+//
+// Usage:
+//
+//	godbvet [flags] packages
+//
+// It takes the package patterns and flags of any go/analysis checker, and can
+// also run under go vet:
+//
+//	go vet -vettool=$(which godbvet) ./...
+package main
+
+import (
+	"github.com/prakhar-5447/GoDB_SDK_GO/godbvet"
+
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(godbvet.Analyzer)
+}
@@ -0,0 +1,348 @@
+// Package godbvet reports risky uses of the GoDB SDK: updates and deletes
+// without a condition, raw conditions built with fmt.Sprintf or string
+// concatenation, and queries without a limit.
+//
+// Calls are resolved with type information, so only methods of the SDK's
+// QueryBuilder, UpdateRecordBuilder, DeleteBuilder, and Table, and its Named
+// and Select functions, are checked. The checks follow builder chains written
+// as a single expression, such as client.Delete(ctx).Table("users").Exec(),
+// and say nothing about builders assembled across statements. A finding is suppressed by a
+// //godbvet:ignore comment on the same line or the line above.
+//
+// Analyzer is a go/analysis Analyzer, so the checks run standalone with the
+// godbvet command, under go vet -vettool, in gopls, or in golangci-lint.
+package godbvet
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Analyzer reports risky uses of the GoDB SDK.
+var Analyzer = &analysis.Analyzer{
+	Name: "godbvet",
+	Doc:  doc,
+	URL:  "https://pkg.go.dev/github.com/prakhar-5447/GoDB_SDK_GO/godbvet",
+	Run:  run,
+}
+
+// doc describes the checks.
+const doc = `report risky uses of the GoDB SDK
+
+godbvet flags update and delete builders executed without a condition, raw
+condition strings built with fmt.Sprintf or concatenation, and query builders
+executed without a limit.`
+
+// ignoreDirective suppresses findings on its line and the next.
+const ignoreDirective = "//godbvet:ignore"
+
+// sdkPath is the import path of the GoDB SDK.
+const sdkPath = "github.com/prakhar-5447/GoDB_SDK_GO"
+
+// checkedTypes are the SDK types whose methods godbvet checks.
+var checkedTypes = map[string]bool{
+	"QueryBuilder":        true,
+	"UpdateRecordBuilder": true,
+	"DeleteBuilder":       true,
+	"Table":               true,
+}
+
+// conditionMethods narrow the rows an update, delete, or query touches.
+var conditionMethods = map[string]bool{
+	"Condition":      true,
+	"Where":          true,
+	"Equal":          true,
+	"Greater":        true,
+	"Less":           true,
+	"LessEqual":      true,
+	"Or":             true,
+	"Not":            true,
+	"In":             true,
+	"NotIn":          true,
+	"Between":        true,
+	"Like":           true,
+	"IsNull":         true,
+	"IsNotNull":      true,
+	"JSONPathEquals": true,
+	"Before":         true,
+	"After":          true,
+	"WithinLast":     true,
+	"Contains":       true,
+	"Overlaps":       true,
+}
+
+// rawConditionMethods take condition strings that are sent as written, as
+// does the Named function.
+var rawConditionMethods = map[string]bool{
+	"Condition": true,
+	"Or":        true,
+	"Not":       true,
+	"Having":    true,
+}
+
+// queryTerminals run a query builder.
+var queryTerminals = map[string]bool{
+	"Exec":   true,
+	"SendTo": true,
+}
+
+// writeTerminals run an update or delete builder.
+var writeTerminals = map[string]bool{
+	"Exec":        true,
+	"ExecCount":   true,
+	"ExecChanges": true,
+	"ExecResult":  true,
+}
+
+// run inspects the files of a package and reports each finding.
+func run(pass *analysis.Pass) (interface{}, error) {
+	for _, f := range pass.Files {
+		c := &checker{fset: pass.Fset, info: pass.TypesInfo, report: pass.Reportf, ignored: ignoredLines(pass.Fset, f)}
+		ast.Inspect(f, c.visit)
+	}
+	return nil, nil
+}
+
+// checker holds the per-file state of a run.
+type checker struct {
+	fset    *token.FileSet
+	info    *types.Info
+	report  func(pos token.Pos, format string, args ...interface{})
+	ignored map[int]bool
+}
+
+// reportf reports a finding unless its line is ignored.
+func (c *checker) reportf(pos token.Pos, format string, args ...interface{}) {
+	if c.ignored[c.fset.Position(pos).Line] {
+		return
+	}
+	c.report(pos, format, args...)
+}
+
+func (c *checker) visit(n ast.Node) bool {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return true
+	}
+	recv, fn := c.callee(call)
+	if fn == nil {
+		return true
+	}
+	name := fn.Name()
+	if rawConditionMethods[name] && checkedTypes[recv] || name == "Named" && recv == "" {
+		for _, arg := range call.Args {
+			c.checkRawCondition(arg)
+		}
+	}
+	switch {
+	case recv == "Table" && (name == "DeleteWhere" || name == "UpdateWhere"):
+		if len(call.Args) >= 2 && c.isEmptyCond(call.Args[1]) {
+			c.reportf(call.Pos(), "%s with an empty condition affects every row of the table", name)
+		}
+	case checkedTypes[recv]:
+		c.checkChain(call, recv, name)
+	case recv == "" && name == "Select" && len(call.Args) > 0:
+		// Select[T](qb, ...) runs qb like Exec.
+		c.checkQuery(call.Args[0], call.Pos())
+	}
+	return true
+}
+
+// callee returns the SDK function or method a call invokes, with the name of
+// the method's receiver type, or "" for a function. fn is nil for calls of
+// anything outside the SDK.
+func (c *checker) callee(call *ast.CallExpr) (recv string, fn *types.Func) {
+	fun := ast.Unparen(call.Fun)
+	switch f := fun.(type) {
+	case *ast.IndexExpr: // Select[T]
+		fun = f.X
+	case *ast.IndexListExpr:
+		fun = f.X
+	}
+	var id *ast.Ident
+	switch f := fun.(type) {
+	case *ast.Ident:
+		id = f
+	case *ast.SelectorExpr:
+		id = f.Sel
+	default:
+		return "", nil
+	}
+	fn, ok := c.info.Uses[id].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != sdkPath {
+		return "", nil
+	}
+	if r := fn.Type().(*types.Signature).Recv(); r != nil {
+		t := r.Type()
+		if p, ok := t.(*types.Pointer); ok {
+			t = p.Elem()
+		}
+		if named, ok := t.(*types.Named); ok {
+			recv = named.Obj().Name()
+		}
+	}
+	return recv, fn
+}
+
+// sdkReceiver returns the SDK type whose method call invokes, or "".
+func (c *checker) sdkReceiver(call *ast.CallExpr) string {
+	recv, _ := c.callee(call)
+	return recv
+}
+
+// checkChain inspects the builder chain run by terminal, a call of the method
+// name of the SDK type recv.
+func (c *checker) checkChain(terminal *ast.CallExpr, recv, name string) {
+	sel, ok := ast.Unparen(terminal.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	switch recv {
+	case "UpdateRecordBuilder", "DeleteBuilder":
+		if !writeTerminals[name] {
+			return
+		}
+		methods, root := chain(sel.X)
+		if root == nil || c.sdkReceiver(root) != "GoDBClient" || len(root.Args) != 1 || !methods["Table"] {
+			return
+		}
+		if !hasAny(methods, conditionMethods) && !methods["DeleteAll"] {
+			op := "update"
+			if recv == "DeleteBuilder" {
+				op = "delete"
+			}
+			c.reportf(terminal.Pos(), "%s without a condition affects every row of the table; add Where or Condition", op)
+		}
+	case "QueryBuilder":
+		if queryTerminals[name] {
+			c.checkQuery(sel.X, terminal.Pos())
+		}
+	}
+}
+
+// checkQuery reports a query builder chain without a limit.
+func (c *checker) checkQuery(expr ast.Expr, pos token.Pos) {
+	methods, root := chain(expr)
+	if root == nil {
+		return
+	}
+	switch recv := c.sdkReceiver(root); {
+	case recv == "GoDBClient" && methodName(root) == "Query":
+		if len(root.Args) != 1 || !methods["Table"] {
+			return
+		}
+	case recv == "Table" && methodName(root) == "Find":
+		if len(root.Args) != 2 {
+			return
+		}
+	default:
+		return
+	}
+	if !methods["Limit"] {
+		c.reportf(pos, "query without a limit may return the whole table; add Limit or use Paginate")
+	}
+}
+
+// checkRawCondition reports a condition string assembled from values.
+func (c *checker) checkRawCondition(arg ast.Expr) {
+	switch e := ast.Unparen(arg).(type) {
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return
+		}
+		if fn, ok := c.info.Uses[sel.Sel].(*types.Func); ok && fn.Pkg() != nil && fn.Pkg().Path() == "fmt" &&
+			strings.HasPrefix(fn.Name(), "Sprint") {
+			c.reportf(e.Pos(), "condition built with fmt.%s is open to injection; use named parameters or Where with Eq, Gt, and the other Cond helpers", fn.Name())
+		}
+	case *ast.BinaryExpr:
+		if e.Op == token.ADD && !isStringLiterals(e) {
+			c.reportf(e.Pos(), "condition built by concatenation is open to injection; use named parameters or Where with Eq, Gt, and the other Cond helpers")
+		}
+	}
+}
+
+// chain walks a builder chain such as a.Query(ctx).Table("t").Limit(1) from
+// its outermost call, returning the names of the methods called after the
+// root and the root call itself. The root is nil when expr is not a call chain.
+func chain(expr ast.Expr) (map[string]bool, *ast.CallExpr) {
+	methods := make(map[string]bool)
+	var root *ast.CallExpr
+	for {
+		call, ok := ast.Unparen(expr).(*ast.CallExpr)
+		if !ok {
+			break
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			break
+		}
+		if root != nil {
+			methods[methodName(root)] = true
+		}
+		root = call
+		expr = sel.X
+	}
+	return methods, root
+}
+
+// methodName returns the selector name of a call such as x.Exec(), or "".
+func methodName(call *ast.CallExpr) string {
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		return sel.Sel.Name
+	}
+	return ""
+}
+
+// hasAny reports whether methods contains any name in set.
+func hasAny(methods, set map[string]bool) bool {
+	for m := range methods {
+		if set[m] {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmptyCond reports whether expr is nil or the SDK's And or Or with no
+// arguments.
+func (c *checker) isEmptyCond(expr ast.Expr) bool {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		return c.info.Types[e].IsNil()
+	case *ast.CallExpr:
+		recv, fn := c.callee(e)
+		return fn != nil && recv == "" && (fn.Name() == "And" || fn.Name() == "Or") && len(e.Args) == 0
+	}
+	return false
+}
+
+// isStringLiterals reports whether expr is made only of string literals.
+func isStringLiterals(expr ast.Expr) bool {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.BasicLit:
+		return e.Kind == token.STRING
+	case *ast.BinaryExpr:
+		return isStringLiterals(e.X) && isStringLiterals(e.Y)
+	}
+	return false
+}
+
+// ignoredLines returns the lines covered by ignore directives.
+func ignoredLines(fset *token.FileSet, f *ast.File) map[int]bool {
+	lines := make(map[int]bool)
+	for _, group := range f.Comments {
+		for _, comment := range group.List {
+			if strings.HasPrefix(comment.Text, ignoreDirective) {
+				line := fset.Position(comment.Pos()).Line
+				lines[line] = true
+				lines[line+1] = true
+			}
+		}
+	}
+	return lines
+}
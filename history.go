@@ -0,0 +1,172 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/prototext"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxHistoryRequest caps the length of a recorded request.
+const maxHistoryRequest = 512
+
+// HistoryEntry records one RPC made by the client.
+type HistoryEntry struct {
+	Time     time.Time
+	Method   string
+	Request  string // text form of the request with credentials redacted
+	Duration time.Duration
+	Code     string // gRPC status code, "OK" on success
+	Err      error
+}
+
+// String formats the entry on one line.
+func (e HistoryEntry) String() string {
+	s := fmt.Sprintf("%s %s %s %v {%s}", e.Time.Format(time.RFC3339Nano), e.Method, e.Code, e.Duration, e.Request)
+	if e.Err != nil {
+		s += ": " + e.Err.Error()
+	}
+	return s
+}
+
+// WithHistory keeps the last n RPCs made by the client, retrievable with History
+// and DumpHistory.
+func WithHistory(n int) Option {
+	return func(o *clientOptions) {
+		if n > 0 {
+			o.history = &history{entries: make([]HistoryEntry, n)}
+		}
+	}
+}
+
+// History returns the recorded RPCs, oldest first. It is empty unless the client
+// was created with WithHistory.
+func (c *GoDBClient) History() []HistoryEntry {
+	if c.history == nil {
+		return nil
+	}
+	return c.history.snapshot()
+}
+
+// DumpHistory writes the recorded RPCs to w, one per line, e.g. from an error
+// handler to show what the application just did.
+func (c *GoDBClient) DumpHistory(w io.Writer) error {
+	for _, e := range c.History() {
+		if _, err := fmt.Fprintln(w, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// history is a ring buffer of recent RPCs.
+type history struct {
+	mu      sync.Mutex
+	entries []HistoryEntry
+	next    int
+	full    bool
+}
+
+// add records e, overwriting the oldest entry when the buffer is full.
+func (h *history) add(e HistoryEntry) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.entries[h.next] = e
+	h.next = (h.next + 1) % len(h.entries)
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+// snapshot returns the entries in order.
+func (h *history) snapshot() []HistoryEntry {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.full {
+		return append([]HistoryEntry(nil), h.entries[:h.next]...)
+	}
+	return append(append([]HistoryEntry(nil), h.entries[h.next:]...), h.entries[:h.next]...)
+}
+
+// record adds an entry for a finished call.
+func (h *history) record(method string, req interface{}, start time.Time, err error) {
+	h.add(HistoryEntry{
+		Time:     start,
+		Method:   method,
+		Request:  redactRequest(req),
+		Duration: time.Since(start),
+		Code:     status.Code(err).String(),
+		Err:      err,
+	})
+}
+
+// unaryInterceptor records each unary call.
+func (h *history) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	h.record(method, req, start, err)
+	return err
+}
+
+// streamInterceptor records the opening of each stream.
+func (h *history) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	start := time.Now()
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	h.record(method, nil, start, err)
+	return stream, err
+}
+
+// redactRequest renders req as compact text with credentials masked,
+// truncated to maxHistoryRequest bytes.
+func redactRequest(req interface{}) string {
+	m, ok := req.(protobuf.Message)
+	if !ok {
+		return ""
+	}
+	s := prototext.MarshalOptions{}.Format(Redact(m))
+	if len(s) > maxHistoryRequest {
+		s = s[:maxHistoryRequest] + "..."
+	}
+	return s
+}
+
+// Redact returns a copy of m with its credentials masked, as the call history
+// and the logger show them: password, token, and secret fields, and the
+// passwords in connection strings, in m and every message nested in it.
+func Redact(m protobuf.Message) protobuf.Message {
+	m = protobuf.Clone(m)
+	redactMessage(m.ProtoReflect())
+	return m
+}
+
+// redactMessage masks, in place and in every nested message, the fields
+// carrying credentials: passwords, tokens, secrets, and the passwords in
+// connection strings.
+func redactMessage(r protoreflect.Message) {
+	r.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch name := string(fd.Name()); {
+		case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(list.Get(i).Message())
+			}
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsMap():
+			redactMessage(v.Message())
+		case fd.Kind() != protoreflect.StringKind || fd.IsList() || fd.IsMap():
+			// Only single string fields carry credentials.
+		case strings.HasSuffix(name, "password"), strings.HasSuffix(name, "token"), strings.HasSuffix(name, "secret"):
+			r.Set(fd, protoreflect.ValueOfString("***"))
+		case strings.HasSuffix(name, "connection_string"):
+			r.Set(fd, protoreflect.ValueOfString(redactConnectionString(v.String())))
+		}
+		return true
+	})
+}
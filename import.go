@@ -0,0 +1,549 @@
+package godb
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultImportBatchSize = 500
+	// importSampleRows is how many rows InferSchema looks at.
+	importSampleRows = 100
+)
+
+// ImportRowError describes a row that could not be read or inserted.
+type ImportRowError struct {
+	// Row is the 1-based position of the row in the input, not counting the
+	// CSV header.
+	Row    int
+	Values map[string]interface{}
+	Err    error
+}
+
+// ImportBuilder provides a fluent interface for loading rows from a file or
+// other reader into a table.
+type ImportBuilder struct {
+	client           *GoDBClient
+	ctx              context.Context
+	connectionString string
+	tableName        string
+	r                io.Reader
+	format           ExportFormat
+	delimiter        rune
+	gzip             bool
+	inferSchema      bool
+	batchSize        int
+	progress         func(imported int)
+	onError          func(ImportRowError)
+}
+
+// Import returns a new ImportBuilder reading CSV with a header line, using the
+// client's stored connection string.
+func (client *GoDBClient) Import(ctx context.Context) *ImportBuilder {
+	return &ImportBuilder{
+		client:           client,
+		ctx:              ctx,
+		connectionString: client.connectionStringFor(ctx),
+		format:           CSV,
+		delimiter:        ',',
+		batchSize:        defaultImportBatchSize,
+	}
+}
+
+// Table sets the table name.
+func (ib *ImportBuilder) Table(table string) *ImportBuilder {
+	ib.tableName = table
+	return ib
+}
+
+// From sets the reader rows are read from.
+func (ib *ImportBuilder) From(r io.Reader) *ImportBuilder {
+	ib.r = r
+	return ib
+}
+
+// Format sets the input format. CSV input must start with a header line of
+// column names; empty fields are NULL. The default is CSV.
+func (ib *ImportBuilder) Format(f ExportFormat) *ImportBuilder {
+	ib.format = f
+	return ib
+}
+
+// Delimiter sets the CSV field delimiter. The default is ','.
+func (ib *ImportBuilder) Delimiter(r rune) *ImportBuilder {
+	ib.delimiter = r
+	return ib
+}
+
+// Gzip decompresses the input with gzip.
+func (ib *ImportBuilder) Gzip() *ImportBuilder {
+	ib.gzip = true
+	return ib
+}
+
+// InferSchema creates the table when it does not exist, with the columns
+// found in the first rows, typed INTEGER, REAL, or TEXT by the values seen.
+// CSV values of INTEGER and REAL columns, whether inferred or those of an
+// existing table, are sent as numbers; a value that does not parse is a bad
+// row. Columns of other types keep their text as written, e.g. "007".
+func (ib *ImportBuilder) InferSchema() *ImportBuilder {
+	ib.inferSchema = true
+	return ib
+}
+
+// BatchSize sets the number of rows sent per insert request. The default is 500.
+func (ib *ImportBuilder) BatchSize(n int) *ImportBuilder {
+	ib.batchSize = n
+	return ib
+}
+
+// Progress calls fn with the number of rows imported so far after each batch.
+func (ib *ImportBuilder) Progress(fn func(imported int)) *ImportBuilder {
+	ib.progress = fn
+	return ib
+}
+
+// OnError hands rows that cannot be read or inserted to fn and carries on
+// with the rest. Without it the first bad row stops the import. Rows of a
+// batch the server rejects are retried one at a time to single out the bad
+// ones.
+func (ib *ImportBuilder) OnError(fn func(ImportRowError)) *ImportBuilder {
+	ib.onError = fn
+	return ib
+}
+
+// Exec runs the import and returns the number of rows inserted, which is also
+// reported when the import stops part way. Batches are not atomic.
+func (ib *ImportBuilder) Exec() (_ int, err error) {
+	defer wrapOpError(&err, "Import", ib.tableName, proto.DatabaseService_InsertMultipleRecords_FullMethodName, time.Now())
+	if ib.tableName == "" {
+		return 0, fmt.Errorf("table name is required")
+	}
+	if ib.r == nil {
+		return 0, fmt.Errorf("reader is required")
+	}
+	if ib.batchSize <= 0 {
+		return 0, fmt.Errorf("batch size must be positive")
+	}
+	r := ib.r
+	if ib.gzip {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return 0, err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	rows, err := ib.newRowReader(r)
+	if err != nil {
+		return 0, err
+	}
+
+	// Rows read ahead for schema inference are replayed first.
+	var pending []importRow
+	var types map[string]ColumnType
+	if ib.inferSchema {
+		for len(pending) < importSampleRows {
+			row, err := rows.next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				if err := ib.rowFailed(row, err); err != nil {
+					return 0, err
+				}
+				continue
+			}
+			pending = append(pending, row)
+		}
+		if types, err = ib.ensureTable(rows.columns(), pending); err != nil {
+			return 0, err
+		}
+	}
+
+	imported := 0
+	var batch []importRow
+	flush := func() error {
+		n, err := ib.insertBatch(batch, types)
+		imported += n
+		batch = batch[:0]
+		if err != nil {
+			return err
+		}
+		if ib.progress != nil {
+			ib.progress(imported)
+		}
+		return nil
+	}
+	for {
+		var row importRow
+		var err error
+		if len(pending) > 0 {
+			row, pending = pending[0], pending[1:]
+		} else if row, err = rows.next(); err == io.EOF {
+			break
+		}
+		if err != nil {
+			if err := ib.rowFailed(row, err); err != nil {
+				return imported, err
+			}
+			continue
+		}
+		batch = append(batch, row)
+		if len(batch) == ib.batchSize {
+			if err := flush(); err != nil {
+				return imported, err
+			}
+		}
+	}
+	if len(batch) > 0 {
+		if err := flush(); err != nil {
+			return imported, err
+		}
+	}
+	return imported, nil
+}
+
+// rowFailed reports a bad row to the error handler, or returns the error to
+// stop the import when there is none.
+func (ib *ImportBuilder) rowFailed(row importRow, err error) error {
+	if ib.onError == nil {
+		return fmt.Errorf("row %d: %w", row.n, err)
+	}
+	ib.onError(ImportRowError{Row: row.n, Values: row.values, Err: err})
+	return nil
+}
+
+// insertBatch inserts rows, falling back to one row at a time when the server
+// rejects the batch and there is an error handler to give bad rows to.
+func (ib *ImportBuilder) insertBatch(batch []importRow, types map[string]ColumnType) (int, error) {
+	records := make([]*proto.Record, 0, len(batch))
+	rows := make([]importRow, 0, len(batch))
+	for _, row := range batch {
+		rec, err := ib.record(row, types)
+		if err != nil {
+			if err := ib.rowFailed(row, err); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		records = append(records, rec)
+		rows = append(rows, row)
+	}
+	if len(records) == 0 {
+		return 0, nil
+	}
+	err := ib.insert(records)
+	if err == nil {
+		return len(records), nil
+	}
+	if ib.onError == nil || !rowError(err) {
+		return 0, fmt.Errorf("rows %d to %d: %w", rows[0].n, rows[len(rows)-1].n, err)
+	}
+	inserted := 0
+	for i, rec := range records {
+		if len(records) > 1 {
+			err = ib.insert([]*proto.Record{rec})
+		}
+		if err != nil {
+			if !rowError(err) {
+				return inserted, fmt.Errorf("row %d: %w", rows[i].n, err)
+			}
+			ib.onError(ImportRowError{Row: rows[i].n, Values: rows[i].values, Err: err})
+			continue
+		}
+		inserted++
+	}
+	return inserted, nil
+}
+
+// rowError reports whether err is the server rejecting the rows themselves
+// rather than failing to run the insert.
+func rowError(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.AlreadyExists, codes.FailedPrecondition, codes.OutOfRange:
+		return true
+	}
+	return false
+}
+
+// insert sends records in one request.
+func (ib *ImportBuilder) insert(records []*proto.Record) error {
+	imb := ib.client.InsertMultiple(ib.ctx).Table(ib.tableName).BatchSize(0)
+	imb.connectionString = ib.connectionString
+	imb.records = records
+	_, err := imb.ExecResult()
+	return err
+}
+
+// record encodes a row, converting CSV values of numeric columns.
+func (ib *ImportBuilder) record(row importRow, types map[string]ColumnType) (*proto.Record, error) {
+	data := make(map[string]string, len(row.values))
+	typed := make(map[string]*proto.Value)
+	for col, v := range row.values {
+		if s, ok := v.(string); ok && ib.format == CSV {
+			var err error
+			switch types[col] {
+			case Int:
+				v, err = strconv.ParseInt(s, 10, 64)
+			case Real:
+				v, err = strconv.ParseFloat(s, 64)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("column %s: %q is not a valid %s", col, s, types[col])
+			}
+		}
+		if err := setColumn(ib.client, data, typed, col, v); err != nil {
+			return nil, fmt.Errorf("column %s: %w", col, err)
+		}
+	}
+	data, typed = extractNulls(data, typed)
+	return &proto.Record{Data: data, TypedData: typed}, nil
+}
+
+// ensureTable creates the table from sample rows unless it exists, returning
+// the column types: those of the existing table, or the inferred ones.
+func (ib *ImportBuilder) ensureTable(columns []string, sample []importRow) (map[string]ColumnType, error) {
+	desc, err := ib.client.DescribeTable(ib.ctx, ib.tableName, ib.connectionString)
+	if err != nil {
+		return nil, err
+	}
+	if desc.Exists {
+		return describedColumnTypes(desc.Columns), nil
+	}
+	types := inferColumnTypes(columns, sample)
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("no columns to infer a schema from")
+	}
+	ctb := ib.client.NewTable(ib.ctx).Table(ib.tableName)
+	ctb.connectionString = ib.connectionString
+	for _, col := range columns {
+		ctb.Column(col, types[col])
+	}
+	if _, err := ctb.Exec(); err != nil {
+		return nil, err
+	}
+	return types, nil
+}
+
+// describedColumnTypes returns the types of an existing table's columns,
+// telling INTEGER and REAL columns, which take numbers, from the rest.
+func describedColumnTypes(columns []*proto.ColumnInfo) map[string]ColumnType {
+	types := make(map[string]ColumnType, len(columns))
+	for _, col := range columns {
+		fields := strings.Fields(strings.ToUpper(col.Type))
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "INTEGER", "INT", "BIGINT", "SMALLINT":
+			types[col.Name] = Int
+		case "REAL", "FLOAT", "DOUBLE":
+			types[col.Name] = Real
+		default:
+			types[col.Name] = ColumnType(fields[0])
+		}
+	}
+	return types
+}
+
+// inferColumnTypes types each column by the narrowest of INTEGER, REAL, and
+// TEXT that holds every sampled value. Columns without values are TEXT.
+func inferColumnTypes(columns []string, sample []importRow) map[string]ColumnType {
+	types := make(map[string]ColumnType, len(columns))
+	for _, col := range columns {
+		t := ColumnType("")
+		for _, row := range sample {
+			v, ok := row.values[col]
+			if !ok || v == nil {
+				continue
+			}
+			t = widenType(t, valueType(v))
+		}
+		if t == "" {
+			t = Text
+		}
+		types[col] = t
+	}
+	return types
+}
+
+// valueType returns the narrowest column type holding v.
+func valueType(v interface{}) ColumnType {
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case int64:
+		return Int
+	case float64:
+		return Real
+	default:
+		return Text
+	}
+	if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return Int
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return Real
+	}
+	return Text
+}
+
+// widenType returns the narrowest type holding values of both a and b.
+func widenType(a, b ColumnType) ColumnType {
+	switch {
+	case a == "" || a == b:
+		return b
+	case (a == Int && b == Real) || (a == Real && b == Int):
+		return Real
+	}
+	return Text
+}
+
+// importRow is a row read from the input; absent columns are NULL.
+type importRow struct {
+	n      int
+	values map[string]interface{}
+}
+
+// rowReader reads rows in one input format.
+type rowReader interface {
+	next() (importRow, error)
+	// columns returns the columns seen so far, in input order.
+	columns() []string
+}
+
+// newRowReader returns a reader for the builder's format.
+func (ib *ImportBuilder) newRowReader(r io.Reader) (rowReader, error) {
+	switch ib.format {
+	case CSV:
+		cr := csv.NewReader(r)
+		cr.Comma = ib.delimiter
+		cr.ReuseRecord = true
+		header, err := cr.Read()
+		if err == io.EOF {
+			return nil, fmt.Errorf("input has no header line")
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &csvRowReader{r: cr, header: append([]string(nil), header...)}, nil
+	case JSONLines:
+		return &jsonRowReader{r: bufio.NewReader(r), seen: make(map[string]bool)}, nil
+	}
+	return nil, fmt.Errorf("unknown import format %d", ib.format)
+}
+
+// csvRowReader reads CSV records after the header line.
+type csvRowReader struct {
+	r      *csv.Reader
+	header []string
+	n      int
+}
+
+func (cr *csvRowReader) next() (importRow, error) {
+	record, err := cr.r.Read()
+	if err == io.EOF {
+		return importRow{}, err
+	}
+	cr.n++
+	row := importRow{n: cr.n}
+	var perr *csv.ParseError
+	if err != nil && !errors.As(err, &perr) {
+		return row, err
+	}
+	if err == nil && len(record) != len(cr.header) {
+		err = fmt.Errorf("has %d fields, header has %d", len(record), len(cr.header))
+	}
+	row.values = make(map[string]interface{}, len(record))
+	for i, v := range record {
+		if i < len(cr.header) && v != "" {
+			row.values[cr.header[i]] = v
+		}
+	}
+	return row, err
+}
+
+func (cr *csvRowReader) columns() []string {
+	return cr.header
+}
+
+// jsonRowReader reads one JSON object per line; blank lines are skipped.
+type jsonRowReader struct {
+	r    *bufio.Reader
+	n    int
+	cols []string
+	seen map[string]bool
+}
+
+func (jr *jsonRowReader) next() (importRow, error) {
+	for {
+		line, err := jr.r.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			return importRow{}, err
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			if err != nil {
+				return importRow{}, err
+			}
+			continue
+		}
+		jr.n++
+		row := importRow{n: jr.n}
+		dec := json.NewDecoder(bytes.NewReader(line))
+		dec.UseNumber()
+		if err := dec.Decode(&row.values); err != nil {
+			return row, err
+		}
+		var added []string
+		for col, v := range row.values {
+			row.values[col] = jsonValue(v)
+			if !jr.seen[col] {
+				jr.seen[col] = true
+				added = append(added, col)
+			}
+		}
+		sort.Strings(added)
+		jr.cols = append(jr.cols, added...)
+		return row, nil
+	}
+}
+
+func (jr *jsonRowReader) columns() []string {
+	return jr.cols
+}
+
+// jsonValue converts a decoded JSON value for insertion: numbers to int64 when
+// whole and in range and to float64 otherwise, and objects and arrays to their
+// JSON text.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}, []interface{}:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+	return v
+}
@@ -0,0 +1,64 @@
+package godb
+
+import (
+	"context"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// Querier reads records. It is implemented by *GoDBClient; accept it instead of
+// the concrete client in code that only reads.
+type Querier interface {
+	Query(ctx context.Context) *QueryBuilder
+}
+
+// Inserter writes, updates, and deletes records. It is implemented by *GoDBClient.
+type Inserter interface {
+	Insert(ctx context.Context) *InsertBuilder
+	InsertMultiple(ctx context.Context) *InsertMultipleBuilder
+	Upsert(ctx context.Context) *UpsertBuilder
+	UpdateRecord(ctx context.Context) *UpdateRecordBuilder
+	Delete(ctx context.Context) *DeleteBuilder
+}
+
+// SchemaAdmin creates and alters tables. It is implemented by *GoDBClient.
+type SchemaAdmin interface {
+	CreateTable(ctx context.Context, tableName string, columns map[string]string, connectionString string) (string, error)
+	UpdateTable(ctx context.Context) *UpdateTableBuilder
+	DescribeTable(ctx context.Context, tableName, connectionString string) (*proto.DescribeTableResponse, error)
+	AutoMigrate(ctx context.Context, models ...interface{}) error
+}
+
+// IndexAdmin manages indexes. It is implemented by *GoDBClient.
+type IndexAdmin interface {
+	AddIndex(ctx context.Context, tableName, indexName string, columns []string, connectionString string) (string, error)
+	AddIndexAsync(ctx context.Context, tableName, indexName string, columns []string, connectionString string) (*Operation, error)
+	DeleteIndex(ctx context.Context, indexName, connectionString string) (string, error)
+	ListIndexes(ctx context.Context, connectionString string) (*proto.ListIndexesResponse, error)
+}
+
+var (
+	_ Querier     = (*GoDBClient)(nil)
+	_ Inserter    = (*GoDBClient)(nil)
+	_ SchemaAdmin = (*GoDBClient)(nil)
+	_ IndexAdmin  = (*GoDBClient)(nil)
+)
+
+// NewGoDBClientFromService returns a client whose builders send their requests
+// to svc instead of a network connection. Pass a fake proto.DatabaseServiceClient
+// to exercise code written against Querier, Inserter, SchemaAdmin, or IndexAdmin
+// in tests. Connection options such as dial options and interceptors are ignored.
+func NewGoDBClientFromService(svc proto.DatabaseServiceClient, opts ...Option) *GoDBClient {
+	var o clientOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &GoDBClient{
+		client:           svc,
+		connectionString: o.connectionString,
+		recoverPanics:    o.recoverPanics,
+		codec:            o.codec,
+		watcher:          newStateWatcher(0),
+		auth:             &authState{},
+	}
+}
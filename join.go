@@ -0,0 +1,239 @@
+package godb
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// JoinOption configures HashJoin and NewHashJoin.
+type JoinOption func(*joinConfig)
+
+// joinConfig holds the join settings.
+type joinConfig struct {
+	rightKey  string
+	leftOuter bool
+	prefix    string
+}
+
+// WithRightKey joins on the given right-hand column instead of onKey, for
+// keys named differently on each side, e.g. orders.user_id and users.id.
+func WithRightKey(column string) JoinOption {
+	return func(c *joinConfig) {
+		c.rightKey = column
+	}
+}
+
+// WithLeftOuter keeps left rows without a match, as a LEFT JOIN does.
+func WithLeftOuter() JoinOption {
+	return func(c *joinConfig) {
+		c.leftOuter = true
+	}
+}
+
+// WithRightPrefix sets the prefix given to right-hand columns whose names are
+// already taken by the left row. The default is "right.".
+func WithRightPrefix(prefix string) JoinOption {
+	return func(c *joinConfig) {
+		c.prefix = prefix
+	}
+}
+
+// HashJoiner joins rows against a right-hand row set indexed by its key. Build
+// it once and call Join for each page of a left-hand stream, e.g. from
+// Page.ForEachPage, to join results from two databases without holding both
+// sides in memory.
+type HashJoiner struct {
+	cfg     joinConfig
+	leftKey string
+	index   map[string][]map[string]string
+}
+
+// NewHashJoin indexes right on onKey for joining with rows whose onKey column
+// holds the same value. Rows without the key column never match.
+func NewHashJoin(right []map[string]string, onKey string, opts ...JoinOption) *HashJoiner {
+	cfg := joinConfig{rightKey: onKey, prefix: "right."}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	j := &HashJoiner{cfg: cfg, leftKey: onKey, index: make(map[string][]map[string]string)}
+	for _, row := range right {
+		if key, ok := row[cfg.rightKey]; ok {
+			j.index[key] = append(j.index[key], row)
+		}
+	}
+	return j
+}
+
+// Join returns a row for each pair of a left row and a matching right row, in
+// left order. Joined rows hold the left row's columns and the right row's
+// other columns, prefixed where the names collide; the inputs are not changed.
+func (j *HashJoiner) Join(left []map[string]string) []map[string]string {
+	var out []map[string]string
+	for _, l := range left {
+		var matches []map[string]string
+		if key, ok := l[j.leftKey]; ok {
+			matches = j.index[key]
+		}
+		if len(matches) == 0 && j.cfg.leftOuter {
+			out = append(out, copyRow(l, 0))
+		}
+		for _, r := range matches {
+			row := copyRow(l, len(r))
+			for col, v := range r {
+				if col == j.cfg.rightKey {
+					continue
+				}
+				if _, taken := row[col]; taken {
+					col = j.cfg.prefix + col
+				}
+				row[col] = v
+			}
+			out = append(out, row)
+		}
+	}
+	return out
+}
+
+// HashJoin joins left and right on onKey; see NewHashJoin and Join.
+func HashJoin(left, right []map[string]string, onKey string, opts ...JoinOption) []map[string]string {
+	return NewHashJoin(right, onKey, opts...).Join(left)
+}
+
+// Merge combines row sets that are each sorted by orderBy, a clause such as
+// "created_at DESC, id", into one sorted set, as when the same query runs
+// against several databases. Values that all parse as numbers compare
+// numerically, others as strings; a missing value sorts first.
+func Merge(orderBy string, sets ...[]map[string]string) []map[string]string {
+	terms := parseOrderTerms(orderBy)
+	var out []map[string]string
+	pos := make([]int, len(sets))
+	for {
+		best := -1
+		for i, set := range sets {
+			if pos[i] == len(set) {
+				continue
+			}
+			if best < 0 || compareRows(set[pos[i]], sets[best][pos[best]], terms) < 0 {
+				best = i
+			}
+		}
+		if best < 0 {
+			return out
+		}
+		out = append(out, sets[best][pos[best]])
+		pos[best]++
+	}
+}
+
+// Union concatenates row sets, dropping rows whose key column repeats an
+// earlier row's; the first occurrence is kept. With an empty key, rows are
+// duplicates when all their columns match. Rows without the key are kept.
+func Union(key string, sets ...[]map[string]string) []map[string]string {
+	seen := make(map[string]bool)
+	var out []map[string]string
+	for _, set := range sets {
+		for _, row := range set {
+			var id string
+			if key == "" {
+				id = rowKey(row)
+			} else if v, ok := row[key]; ok {
+				id = v
+			} else {
+				out = append(out, row)
+				continue
+			}
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			out = append(out, row)
+		}
+	}
+	return out
+}
+
+// orderTerm is one column of an ORDER BY clause.
+type orderTerm struct {
+	column string
+	desc   bool
+}
+
+// parseOrderTerms splits an ORDER BY clause into its columns.
+func parseOrderTerms(orderBy string) []orderTerm {
+	var terms []orderTerm
+	for _, term := range strings.Split(orderBy, ",") {
+		fields := strings.Fields(term)
+		if len(fields) == 0 {
+			continue
+		}
+		terms = append(terms, orderTerm{
+			column: fields[0],
+			desc:   len(fields) > 1 && strings.EqualFold(fields[1], "DESC"),
+		})
+	}
+	return terms
+}
+
+// compareRows orders a and b by terms.
+func compareRows(a, b map[string]string, terms []orderTerm) int {
+	for _, t := range terms {
+		c := compareValues(a, b, t.column)
+		if t.desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareValues orders the column's values in a and b.
+func compareValues(a, b map[string]string, column string) int {
+	av, aok := a[column]
+	bv, bok := b[column]
+	switch {
+	case !aok && !bok:
+		return 0
+	case !aok:
+		return -1
+	case !bok:
+		return 1
+	}
+	af, aerr := strconv.ParseFloat(av, 64)
+	bf, berr := strconv.ParseFloat(bv, 64)
+	if aerr == nil && berr == nil {
+		switch {
+		case af < bf:
+			return -1
+		case af > bf:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(av, bv)
+}
+
+// rowKey identifies a row by all of its columns.
+func rowKey(row map[string]string) string {
+	cols := make([]string, 0, len(row))
+	for col := range row {
+		cols = append(cols, col)
+	}
+	sort.Strings(cols)
+	parts := make([]string, 0, 2*len(cols))
+	for _, col := range cols {
+		parts = append(parts, col, row[col])
+	}
+	return hashKey(parts...)
+}
+
+// copyRow returns a copy of row with room for extra more columns.
+func copyRow(row map[string]string, extra int) map[string]string {
+	out := make(map[string]string, len(row)+extra)
+	for k, v := range row {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,370 @@
+package godb
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// journalRetryInterval is how often pending writes are retried while the
+// connection does not report becoming ready.
+const journalRetryInterval = 5 * time.Second
+
+// journaledMethods are the row writes that can be journaled, with constructors
+// for their request and response messages.
+var journaledMethods = map[string]struct {
+	newRequest, newReply func() protobuf.Message
+}{
+	proto.DatabaseService_InsertRecord_FullMethodName: {
+		func() protobuf.Message { return &proto.InsertRecordRequest{} },
+		func() protobuf.Message { return &proto.InsertRecordResponse{} },
+	},
+	proto.DatabaseService_InsertMultipleRecords_FullMethodName: {
+		func() protobuf.Message { return &proto.InsertMultipleRecordsRequest{} },
+		func() protobuf.Message { return &proto.InsertMultipleRecordsResponse{} },
+	},
+	proto.DatabaseService_UpsertRecord_FullMethodName: {
+		func() protobuf.Message { return &proto.UpsertRecordRequest{} },
+		func() protobuf.Message { return &proto.UpsertRecordResponse{} },
+	},
+	proto.DatabaseService_UpdateRecord_FullMethodName: {
+		func() protobuf.Message { return &proto.UpdateRecordRequest{} },
+		func() protobuf.Message { return &proto.UpdateRecordResponse{} },
+	},
+	proto.DatabaseService_DeleteRecord_FullMethodName: {
+		func() protobuf.Message { return &proto.DeleteRecordRequest{} },
+		func() protobuf.Message { return &proto.DeleteRecordResponse{} },
+	},
+}
+
+// JournalConflict reports a journaled write the server rejected on replay.
+type JournalConflict struct {
+	Method   string
+	Table    string
+	Request  interface{} // the request message, e.g. *proto.InsertRecordRequest
+	QueuedAt time.Time
+	Err      error
+}
+
+// WithOfflineJournal keeps writes made while the server is unreachable in an
+// append-only file at path and replays them, in order, once the connection is
+// back. Inserts, upserts, updates, and deletes that fail with Unavailable are
+// journaled and reported as successful with the message "journaled"; while a
+// table has journaled writes, later writes to it are journaled too so they
+// cannot overtake. Writes inside a transaction are never journaled. Writes the
+// server rejects on replay are passed to onConflict, if not nil, and dropped.
+// Replay is at least once: a write may be sent again if the client stops part
+// way through a replay. The file holds the requests, connection strings
+// included, and is created with mode 0600.
+func WithOfflineJournal(path string, onConflict func(*JournalConflict)) Option {
+	return func(o *clientOptions) {
+		o.journal = &journal{path: path, onConflict: onConflict}
+	}
+}
+
+// PendingWrites returns the number of journaled writes not yet replayed.
+func (c *GoDBClient) PendingWrites() int {
+	if c.journal == nil {
+		return 0
+	}
+	c.journal.mu.Lock()
+	defer c.journal.mu.Unlock()
+	return len(c.journal.entries)
+}
+
+// ReplayJournal sends the journaled writes now instead of waiting for the
+// background replay. It stops at the first write that fails because the
+// server is still unreachable.
+func (c *GoDBClient) ReplayJournal(ctx context.Context) error {
+	if c.journal == nil {
+		return nil
+	}
+	return c.journal.replay(ctx)
+}
+
+// journalEntry is one journaled write, stored as a line of JSON.
+type journalEntry struct {
+	Time    time.Time `json:"time"`
+	Method  string    `json:"method"`
+	Table   string    `json:"table"`
+	Key     string    `json:"key"`
+	Request []byte    `json:"request"`
+}
+
+// journal persists writes made while offline and replays them.
+type journal struct {
+	path       string
+	onConflict func(*JournalConflict)
+
+	mu      sync.Mutex
+	file    *os.File
+	entries []journalEntry
+	pending map[string]int // entries per table key
+
+	replayMu sync.Mutex
+	cc       grpc.ClientConnInterface
+	wake     chan struct{}
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// replayingKey marks contexts of replayed calls.
+type replayingKey struct{}
+
+// open loads the entries left by an earlier run and opens the file for
+// appending.
+func (j *journal) open() error {
+	j.pending = make(map[string]int)
+	if f, err := os.Open(j.path); err == nil {
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(nil, 64<<20)
+		for scanner.Scan() {
+			var e journalEntry
+			if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+				// A torn final line from a crash mid-append; nothing after it was synced.
+				break
+			}
+			j.entries = append(j.entries, e)
+			j.pending[e.Key]++
+		}
+		f.Close()
+		if err := scanner.Err(); err != nil {
+			return fmt.Errorf("reading journal: %w", err)
+		}
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("opening journal: %w", err)
+	}
+	// Rewrite to drop any torn line before appending after it.
+	return j.compact()
+}
+
+// start begins replaying in the background through cc.
+func (j *journal) start(cc grpc.ClientConnInterface) {
+	j.cc = cc
+	j.wake = make(chan struct{}, 1)
+	j.stop = make(chan struct{})
+	j.done = make(chan struct{})
+	go j.run()
+	if len(j.entries) > 0 {
+		j.kick()
+	}
+}
+
+// run replays pending entries when woken or periodically.
+func (j *journal) run() {
+	defer close(j.done)
+	ticker := time.NewTicker(journalRetryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-j.stop:
+			return
+		case <-j.wake:
+		case <-ticker.C:
+		}
+		j.mu.Lock()
+		n := len(j.entries)
+		j.mu.Unlock()
+		if n == 0 {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		j.replay(ctx)
+		cancel()
+	}
+}
+
+// kick wakes the replay loop.
+func (j *journal) kick() {
+	select {
+	case j.wake <- struct{}{}:
+	default:
+	}
+}
+
+// close stops the replay loop and closes the file.
+func (j *journal) close() error {
+	if j.stop != nil {
+		close(j.stop)
+		<-j.done
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.file.Close()
+}
+
+// interceptor journals row writes that cannot reach the server.
+func (j *journal) interceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if _, ok := journaledMethods[method]; !ok || ctx.Value(replayingKey{}) != nil {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	if _, inTx := TxFromContext(ctx); inTx {
+		// A transaction cannot outlive the connection, so its writes are not replayable.
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	tr := req.(tableRequest)
+	key := hashKey(tr.GetConnectionString(), tr.GetTableName())
+	j.mu.Lock()
+	queued := j.pending[key] > 0
+	j.mu.Unlock()
+	if !queued {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if status.Code(err) != codes.Unavailable {
+			return err
+		}
+	}
+	encoded, err := protobuf.Marshal(req.(protobuf.Message))
+	if err != nil {
+		return err
+	}
+	if err := j.append(journalEntry{
+		Time:    time.Now().UTC(),
+		Method:  method,
+		Table:   tr.GetTableName(),
+		Key:     key,
+		Request: encoded,
+	}); err != nil {
+		return fmt.Errorf("journaling write: %w", err)
+	}
+	// Every journaled response has a message field.
+	m := reply.(protobuf.Message).ProtoReflect()
+	if fd := m.Descriptor().Fields().ByName("message"); fd != nil {
+		m.Set(fd, protoreflect.ValueOfString("journaled"))
+	}
+	return nil
+}
+
+// append durably adds e to the journal.
+func (j *journal) append(e journalEntry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if _, err := j.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	if err := j.file.Sync(); err != nil {
+		return err
+	}
+	j.entries = append(j.entries, e)
+	j.pending[e.Key]++
+	return nil
+}
+
+// replay sends pending entries in order until the journal is empty or the
+// server is unreachable, then compacts the file.
+func (j *journal) replay(ctx context.Context) error {
+	j.replayMu.Lock()
+	defer j.replayMu.Unlock()
+	ctx = context.WithValue(ctx, replayingKey{}, true)
+	replayed := 0
+	var err error
+	for {
+		j.mu.Lock()
+		if replayed == len(j.entries) {
+			j.mu.Unlock()
+			break
+		}
+		e := j.entries[replayed]
+		j.mu.Unlock()
+
+		kind := journaledMethods[e.Method]
+		req := kind.newRequest()
+		if uerr := protobuf.Unmarshal(e.Request, req); uerr != nil {
+			j.conflict(e, nil, fmt.Errorf("decoding journaled request: %w", uerr))
+			replayed++
+			continue
+		}
+		err = j.cc.Invoke(ctx, e.Method, req, kind.newReply())
+		if status.Code(err) == codes.Unavailable || ctx.Err() != nil {
+			break
+		}
+		if err != nil {
+			j.conflict(e, req, err)
+			err = nil
+		}
+		replayed++
+	}
+	if replayed == 0 {
+		return err
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, e := range j.entries[:replayed] {
+		j.pending[e.Key]--
+		if j.pending[e.Key] == 0 {
+			delete(j.pending, e.Key)
+		}
+	}
+	j.entries = append([]journalEntry(nil), j.entries[replayed:]...)
+	if cerr := j.compact(); cerr != nil && err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// conflict reports a rejected entry.
+func (j *journal) conflict(e journalEntry, req interface{}, err error) {
+	if j.onConflict == nil {
+		return
+	}
+	j.onConflict(&JournalConflict{
+		Method:   e.Method,
+		Table:    e.Table,
+		Request:  req,
+		QueuedAt: e.Time,
+		Err:      err,
+	})
+}
+
+// compact rewrites the file with the pending entries and reopens it for
+// appending. The caller holds j.mu, except during open.
+func (j *journal) compact() error {
+	tmp := j.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	for _, e := range j.entries {
+		line, err := json.Marshal(e)
+		if err != nil {
+			f.Close()
+			return err
+		}
+		w.Write(append(line, '\n'))
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, j.path); err != nil {
+		return err
+	}
+	if j.file != nil {
+		j.file.Close()
+	}
+	j.file, err = os.OpenFile(j.path, os.O_APPEND|os.O_WRONLY, 0o600)
+	return err
+}
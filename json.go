@@ -0,0 +1,88 @@
+package godb
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// marshalJSON encodes v for a JSON column.
+func marshalJSON(field string, v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("column %s: %w", field, err)
+	}
+	return string(b), nil
+}
+
+// ValuesJSON sets field to v marshaled as JSON, for JSON columns.
+func (ib *InsertBuilder) ValuesJSON(field string, v interface{}) *InsertBuilder {
+	encoded, err := marshalJSON(field, v)
+	if err != nil {
+		ib.err = err
+		return ib
+	}
+	return ib.Set(field, encoded)
+}
+
+// ValuesJSON sets field to v marshaled as JSON, for JSON columns.
+func (ub *UpsertBuilder) ValuesJSON(field string, v interface{}) *UpsertBuilder {
+	encoded, err := marshalJSON(field, v)
+	if err != nil {
+		ub.err = err
+		return ub
+	}
+	return ub.Set(field, encoded)
+}
+
+// SetJSON updates field to v marshaled as JSON, for JSON columns.
+func (urb *UpdateRecordBuilder) SetJSON(field string, v interface{}) *UpdateRecordBuilder {
+	encoded, err := marshalJSON(field, v)
+	if err != nil {
+		urb.err = err
+		return urb
+	}
+	return urb.SetUpdate(field, encoded)
+}
+
+// jsonPathCond compares the value at a JSON path of a field.
+type jsonPathCond struct {
+	field string
+	path  string
+	value interface{}
+	exist bool
+}
+
+// render formats "json_extract(field, 'path') = value", or IS NOT NULL when
+// testing that the path exists.
+func (c jsonPathCond) render() (string, error) {
+	if !strings.HasPrefix(c.path, "$") {
+		return "", fmt.Errorf("JSON path %q must start with $", c.path)
+	}
+	extract := fmt.Sprintf("json_extract(%s, %s)", c.field, quoteString(c.path))
+	if c.exist {
+		return extract + " IS NOT NULL", nil
+	}
+	return compareCond{extract, "=", c.value}.render()
+}
+
+// JSONPathEquals matches rows where the JSON in field has value at path, e.g.
+// JSONPathEquals("meta", "$.plan", "pro"). Paths use the $.key[index] syntax.
+func JSONPathEquals(field, path string, value interface{}) Cond {
+	return jsonPathCond{field: field, path: path, value: value}
+}
+
+// JSONPathExists matches rows where the JSON in field has a non-null value at path.
+func JSONPathExists(field, path string) Cond {
+	return jsonPathCond{field: field, path: path, exist: true}
+}
+
+// JSONPathEquals adds a condition on the value at a JSON path of field.
+func (qb *QueryBuilder) JSONPathEquals(field, path string, value interface{}) *QueryBuilder {
+	return qb.Where(JSONPathEquals(field, path, value))
+}
+
+// JSONPathEquals adds a condition on the value at a JSON path of field.
+func (urb *UpdateRecordBuilder) JSONPathEquals(field, path string, value interface{}) *UpdateRecordBuilder {
+	return urb.Where(JSONPathEquals(field, path, value))
+}
@@ -0,0 +1,108 @@
+package godb
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// List is a list-valued column. It is stored as a JSON array of strings, e.g.
+// ["red","green"], in a TEXT column, which lets Contains and Overlaps match whole
+// elements with a LIKE pattern. If the server gains native array columns the
+// encoding can change here without touching call sites.
+type List []string
+
+// EncodeList returns the wire representation of a list column.
+func EncodeList(values []string) string {
+	if values == nil {
+		values = []string{}
+	}
+	b, _ := json.Marshal(values)
+	return string(b)
+}
+
+// DecodeList parses the wire representation of a list column.
+func DecodeList(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var values []string
+	if err := json.Unmarshal([]byte(s), &values); err != nil {
+		return nil, fmt.Errorf("invalid list value %q: %w", s, err)
+	}
+	return values, nil
+}
+
+// containsCondition matches rows whose list column has value as an element.
+func containsCondition(field, value string) string {
+	element, _ := json.Marshal(value)
+	pattern := "%" + escapeLike(string(element)) + "%"
+	return fmt.Sprintf("%s LIKE %s ESCAPE '\\'", field, quoteString(pattern))
+}
+
+// overlapsCondition matches rows whose list column shares at least one element with values.
+func overlapsCondition(field string, values []string) string {
+	if len(values) == 0 {
+		return "1 = 0"
+	}
+	conds := make([]string, len(values))
+	for i, v := range values {
+		conds[i] = containsCondition(field, v)
+	}
+	return "(" + strings.Join(conds, " OR ") + ")"
+}
+
+// escapeLike escapes LIKE wildcards so s is matched literally with ESCAPE '\'.
+func escapeLike(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return r.Replace(s)
+}
+
+// Contains matches rows whose list column has value as an element.
+func Contains(field, value string) Cond {
+	return rawCond(containsCondition(field, value))
+}
+
+// Overlaps matches rows whose list column contains any of values.
+func Overlaps(field string, values ...string) Cond {
+	return rawCond(overlapsCondition(field, values))
+}
+
+// Contains adds a condition matching rows whose list column contains value.
+func (qb *QueryBuilder) Contains(field, value string) *QueryBuilder {
+	return qb.Where(Contains(field, value))
+}
+
+// Overlaps adds a condition matching rows whose list column contains any of values.
+func (qb *QueryBuilder) Overlaps(field string, values ...string) *QueryBuilder {
+	return qb.Where(Overlaps(field, values...))
+}
+
+// Contains adds a condition matching rows whose list column contains value.
+func (urb *UpdateRecordBuilder) Contains(field, value string) *UpdateRecordBuilder {
+	return urb.Where(Contains(field, value))
+}
+
+// Overlaps adds a condition matching rows whose list column contains any of values.
+func (urb *UpdateRecordBuilder) Overlaps(field string, values ...string) *UpdateRecordBuilder {
+	return urb.Where(Overlaps(field, values...))
+}
+
+// Contains adds a condition matching rows whose list column contains value.
+func (db *DeleteBuilder) Contains(field, value string) *DeleteBuilder {
+	return db.Where(Contains(field, value))
+}
+
+// Overlaps adds a condition matching rows whose list column contains any of values.
+func (db *DeleteBuilder) Overlaps(field string, values ...string) *DeleteBuilder {
+	return db.Where(Overlaps(field, values...))
+}
+
+func init() {
+	RegisterType(func(l List) (string, error) {
+		return EncodeList(l), nil
+	}, func(s string) (List, error) {
+		values, err := DecodeList(s)
+		return List(values), err
+	})
+}
@@ -0,0 +1,128 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// liveQueryKey is the column identifying rows in a live query.
+const liveQueryKey = "id"
+
+// LiveChange is one batch of changes to a live query's result set.
+type LiveChange struct {
+	Added   []map[string]string
+	Updated []map[string]string
+	Removed []map[string]string
+}
+
+// LiveQuery runs qb and calls handler with its rows as additions, then keeps the
+// result set up to date from the table's Watch stream, calling handler with each
+// addition, update, and removal. Rows are identified by their "id" column, and
+// ORDER BY, LIMIT, and OFFSET apply only to the initial result. LiveQuery blocks
+// until ctx is done or the stream fails.
+func (c *GoDBClient) LiveQuery(ctx context.Context, qb *QueryBuilder, handler func(LiveChange)) (err error) {
+	defer wrapOpError(&err, "LiveQuery", qb.tableName, proto.DatabaseService_Watch_FullMethodName, time.Now())
+	condition, err := qb.where()
+	if err != nil {
+		return err
+	}
+	// Subscribe before the initial query so no change in between is missed;
+	// replaying a change already reflected in the result is harmless. The
+	// stream is closed whenever LiveQuery returns, not only when ctx ends.
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.client.Watch(streamCtx, &proto.WatchRequest{
+		ConnectionString: qb.connectionString,
+		TableName:        qb.tableName,
+		Condition:        condition,
+		KeyColumn:        liveQueryKey,
+	})
+	if err != nil {
+		return err
+	}
+	q := *qb
+	q.ctx = ctx
+	resp, err := q.Exec()
+	if err != nil {
+		return err
+	}
+	rows := make(map[string]map[string]string, len(resp.Rows))
+	initial := LiveChange{}
+	for _, row := range resp.Rows {
+		key, ok := row.Data[liveQueryKey]
+		if !ok {
+			return fmt.Errorf("live query results must include the %q column", liveQueryKey)
+		}
+		rows[key] = row.Data
+		initial.Added = append(initial.Added, row.Data)
+	}
+	if _, err := guarded(c, func() (struct{}, error) {
+		handler(initial)
+		return struct{}{}, nil
+	}); err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		change, ok := applyChange(rows, event)
+		if !ok {
+			continue
+		}
+		if _, err := guarded(c, func() (struct{}, error) {
+			handler(change)
+			return struct{}{}, nil
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// applyChange applies event to rows and returns the resulting change, or false
+// when the event does not alter the result set.
+func applyChange(rows map[string]map[string]string, event *proto.ChangeEvent) (LiveChange, bool) {
+	var change LiveChange
+	old, exists := rows[event.Key]
+	switch event.Type {
+	case proto.ChangeType_CHANGE_TYPE_INSERT, proto.ChangeType_CHANGE_TYPE_UPDATE:
+		if exists && rowsEqual(old, event.Row) {
+			return change, false
+		}
+		rows[event.Key] = event.Row
+		if exists {
+			change.Updated = append(change.Updated, event.Row)
+		} else {
+			change.Added = append(change.Added, event.Row)
+		}
+	case proto.ChangeType_CHANGE_TYPE_DELETE:
+		if !exists {
+			return change, false
+		}
+		delete(rows, event.Key)
+		change.Removed = append(change.Removed, old)
+	default:
+		return change, false
+	}
+	return change, true
+}
+
+// rowsEqual reports whether two rows hold the same values.
+func rowsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
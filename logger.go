@@ -0,0 +1,63 @@
+package godb
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Logger receives the client's debug logs as a message followed by alternating
+// keys and values. *slog.Logger satisfies it directly; other loggers, such as
+// zap's SugaredLogger via Debugw, need a one-line adapter.
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+}
+
+// WithLogger logs every RPC at debug level with its table, condition, request
+// size, duration, and response message. Passwords in connection strings are
+// redacted, and credential fields such as CreateUser's password are never logged.
+func WithLogger(l Logger) Option {
+	return func(o *clientOptions) {
+		o.logger = l
+	}
+}
+
+// connectionPassword matches the password in "scheme://user:password/db".
+var connectionPassword = regexp.MustCompile(`(://[^:/@]*:)[^/@]*`)
+
+// redactConnectionString hides the password in a connection string.
+func redactConnectionString(s string) string {
+	return connectionPassword.ReplaceAllString(s, "${1}***")
+}
+
+// loggingInterceptor returns an interceptor logging each call to l.
+func loggingInterceptor(l Logger) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		kv := []interface{}{"method", method}
+		if r, ok := req.(interface{ GetTableName() string }); ok && r.GetTableName() != "" {
+			kv = append(kv, "table", r.GetTableName())
+		}
+		if r, ok := req.(interface{ GetCondition() string }); ok && r.GetCondition() != "" {
+			kv = append(kv, "condition", r.GetCondition())
+		}
+		if r, ok := req.(interface{ GetConnectionString() string }); ok && r.GetConnectionString() != "" {
+			kv = append(kv, "connection", redactConnectionString(r.GetConnectionString()))
+		}
+		if m, ok := req.(protobuf.Message); ok {
+			kv = append(kv, "request_bytes", protobuf.Size(m))
+		}
+		kv = append(kv, "duration", time.Since(start))
+		if err != nil {
+			kv = append(kv, "error", err)
+		} else if r, ok := reply.(interface{ GetMessage() string }); ok && r.GetMessage() != "" {
+			kv = append(kv, "message", r.GetMessage())
+		}
+		l.Debug("godb rpc", kv...)
+		return err
+	}
+}
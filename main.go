@@ -3,8 +3,10 @@ package godb
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
 
@@ -15,34 +17,218 @@ import (
 type GoDBClient struct {
 	client           proto.DatabaseServiceClient
 	conn             *grpc.ClientConn
+	address          string
 	connectionString string
+	recoverPanics    bool
+	telemetry        *telemetry
+	watcher          *stateWatcher
+	readVerify       *readVerifier
+	cache            *clientCache
+	history          *history
+	schemaPins       *schemaPins
+	auth             *authState
+	insertBatchSize  int
+	pool             *connPool
+	journal          *journal
+	columnMigrations columnMigrations
+	codec            Codec
+	tenantDatabase   func(string) string
+	transport        *transportSecurity
 }
 
 // NewGoDBClient creates a new instance of GoDBClient.
 // The address parameter should be the IP and port of your Docker container running the gRPC server,
 // e.g., "172.17.0.2:50051" or a DNS name if using Docker networking.
-func NewGoDBClient(address string) (*GoDBClient, error) {
-	conn, err := grpc.NewClient(address, grpc.WithInsecure())
+func NewGoDBClient(address string, opts ...Option) (*GoDBClient, error) {
+	var o clientOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	watcher := newStateWatcher(o.maxReconnectAttempts)
+	auth := &authState{}
+	interceptors := []grpc.UnaryClientInterceptor{versionUnaryInterceptor, auth.unaryInterceptor, txUnaryInterceptor}
+	streamInterceptors := []grpc.StreamClientInterceptor{versionStreamInterceptor, auth.streamInterceptor, txStreamInterceptor}
+	if o.defaultTimeout > 0 {
+		interceptors = append(interceptors, defaultTimeoutInterceptor(o.defaultTimeout))
+	}
+	if o.deadlineWarning != nil {
+		interceptors = append(interceptors, o.deadlineWarning)
+	}
+	baseOpts := append([]grpc.DialOption{grpc.WithInsecure()}, o.dialOptions...)
+	if o.virtualColumns != nil {
+		// Outside column migrations and encodings, so values are computed from
+		// decoded, migrated columns.
+		interceptors = append(interceptors, o.virtualColumns.interceptor)
+	}
+	if o.columnMigrations != nil {
+		interceptors = append(interceptors, o.columnMigrations.interceptor)
+	}
+	if o.encodings != nil {
+		interceptors = append(interceptors, o.encodings.interceptor)
+	}
+	interceptors = append(interceptors, typedValuesInterceptor)
+	if o.readVerify != nil {
+		// The replica only needs the version header and timeout; caching and
+		// accounting apply to the primary.
+		if err := o.readVerify.dial(append(baseOpts[:len(baseOpts):len(baseOpts)], grpc.WithChainUnaryInterceptor(interceptors...))); err != nil {
+			return nil, err
+		}
+	}
+	if o.versionCheck != nil {
+		interceptors = append(interceptors, o.versionCheck.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, o.versionCheck.streamInterceptor)
+	}
+	if o.schemaPins != nil {
+		interceptors = append(interceptors, o.schemaPins.interceptor)
+	}
+	if o.journal != nil {
+		if err := o.journal.open(); err != nil {
+			return nil, err
+		}
+		interceptors = append(interceptors, o.journal.interceptor)
+	}
+	if o.strictColumns {
+		interceptors = append(interceptors, strictColumnsInterceptor)
+	}
+	var cache *clientCache
+	if o.cacheTTL > 0 {
+		cache = &clientCache{store: o.cacheBackend, ttl: o.cacheTTL}
+		if cache.store == nil {
+			cache.store = NewMemoryCache()
+		}
+		interceptors = append(interceptors, cache.interceptor)
+	}
+	if o.retry != nil {
+		o.retry.onRetry = watcher.metrics.retried
+		interceptors = append(interceptors, o.retry.interceptor)
+	}
+	if o.dnsReresolve != nil {
+		// Inside retries, so each failed attempt counts.
+		interceptors = append(interceptors, o.dnsReresolve.interceptor)
+	}
+	interceptors = append(interceptors, checksumInterceptor)
+	if o.logger != nil {
+		interceptors = append(interceptors, loggingInterceptor(o.logger))
+	}
+	interceptors = append(interceptors, o.unaryInterceptors...)
+	if o.telemetry != nil {
+		interceptors = append(interceptors, o.telemetry.interceptor)
+	}
+	interceptors = append(interceptors, watcher.metrics.unaryInterceptor)
+	streamInterceptors = append(streamInterceptors, watcher.metrics.streamInterceptor)
+	if o.history != nil {
+		interceptors = append(interceptors, o.history.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, o.history.streamInterceptor)
+	}
+	transport := &transportSecurity{}
+	interceptors = append(interceptors, transport.interceptor)
+	if o.compressionThreshold > 0 {
+		// Innermost, so the size is that of the request as sent.
+		interceptors = append(interceptors, compressionInterceptor(o.compressionThreshold))
+	}
+	dialOpts := append(baseOpts,
+		grpc.WithChainUnaryInterceptor(interceptors...),
+		grpc.WithChainStreamInterceptor(streamInterceptors...),
+	)
+	// Extra pooled connections are not counted toward reconnect attempts.
+	poolOpts := dialOpts[:len(dialOpts):len(dialOpts)]
+	if o.maxReconnectAttempts > 0 {
+		dialOpts = append(dialOpts, grpc.WithContextDialer(watcher.dial))
+	}
+	conn, err := grpc.NewClient(address, dialOpts...)
 	if err != nil {
+		if o.readVerify != nil {
+			o.readVerify.conn.Close()
+		}
+		if o.journal != nil {
+			o.journal.close()
+		}
 		return nil, fmt.Errorf("failed to connect to GoDB: %v", err)
 	}
-	client := proto.NewDatabaseServiceClient(conn)
-	return &GoDBClient{client: client, conn: conn}, nil
+	var cc grpc.ClientConnInterface = conn
+	var pool *connPool
+	if o.maxConcurrentStreams > 0 {
+		pool = newConnPool(conn, o.maxConcurrentStreams, func() (*grpc.ClientConn, error) {
+			return grpc.NewClient(address, poolOpts...)
+		})
+		cc = pool
+		watcher.metrics.setPool(pool.stats)
+	}
+	if o.journal != nil {
+		o.journal.start(cc)
+		watcher.subscribe(func(s State) {
+			if s == StateReady {
+				o.journal.kick()
+			}
+		})
+	}
+	// Start watching only once the callbacks above are registered.
+	watcher.start(conn)
+	if o.telemetry != nil {
+		o.telemetry.start()
+	}
+	client := proto.NewDatabaseServiceClient(cc)
+	return &GoDBClient{
+		client:           client,
+		conn:             conn,
+		address:          address,
+		connectionString: o.connectionString,
+		recoverPanics:    o.recoverPanics,
+		telemetry:        o.telemetry,
+		watcher:          watcher,
+		readVerify:       o.readVerify,
+		cache:            cache,
+		history:          o.history,
+		schemaPins:       o.schemaPins,
+		auth:             auth,
+		insertBatchSize:  o.insertBatchSize,
+		pool:             pool,
+		journal:          o.journal,
+		columnMigrations: o.columnMigrations,
+		codec:            o.codec,
+		tenantDatabase:   o.tenantDatabase,
+		transport:        transport,
+	}, nil
 }
 
 // Close closes the underlying gRPC connection.
 func (c *GoDBClient) Close() error {
-	return c.conn.Close()
+	c.auth.stopRefresh()
+	if c.telemetry != nil {
+		c.telemetry.stop()
+	}
+	if c.readVerify != nil {
+		c.readVerify.conn.Close()
+	}
+	if c.journal != nil {
+		c.journal.close()
+	}
+	if c.pool != nil {
+		c.pool.close()
+	}
+	return c.watcher.close()
 }
 
 // SetConnectionString stores the connection string for subsequent operations.
+//
+// Deprecated: changing the connection string of a shared client races with
+// builders in other goroutines. Use WithConnectionString, Database for
+// per-database handles, or WithConnection and WithTenant for per-request
+// connections.
 func (c *GoDBClient) SetConnectionString(connStr string) {
 	c.connectionString = connStr
 }
 
+// ConnectionString returns the connection string the client's builders use
+// when their context carries none.
+func (c *GoDBClient) ConnectionString() string {
+	return c.connectionString
+}
+
 // CreateUser calls the gRPC CreateUser method to register a new user and returns
 // both a message and a connection string with a placeholder for the database name.
-func (c *GoDBClient) CreateUser(ctx context.Context, username, password string) (string, string, error) {
+func (c *GoDBClient) CreateUser(ctx context.Context, username, password string) (_ string, _ string, err error) {
+	defer wrapOpError(&err, "CreateUser", "", proto.DatabaseService_CreateUser_FullMethodName, time.Now())
 	req := &proto.CreateUserRequest{
 		Username: username,
 		Password: password,
@@ -54,8 +240,65 @@ func (c *GoDBClient) CreateUser(ctx context.Context, username, password string)
 	return resp.Message, resp.ConnectionString, nil
 }
 
+// DeleteUser removes a user, authorized by the client's connection string.
+func (c *GoDBClient) DeleteUser(ctx context.Context, username string) (_ string, err error) {
+	defer wrapOpError(&err, "DeleteUser", "", proto.DatabaseService_DeleteUser_FullMethodName, time.Now())
+	req := &proto.DeleteUserRequest{
+		ConnectionString: c.connectionStringFor(ctx),
+		Username:         username,
+	}
+	resp, err := c.client.DeleteUser(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Message, nil
+}
+
+// ChangePassword replaces a user's password and returns a message and the
+// connection string carrying the new password.
+func (c *GoDBClient) ChangePassword(ctx context.Context, username, oldPassword, newPassword string) (_ string, _ string, err error) {
+	defer wrapOpError(&err, "ChangePassword", "", proto.DatabaseService_ChangePassword_FullMethodName, time.Now())
+	req := &proto.ChangePasswordRequest{
+		Username:    username,
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+	}
+	resp, err := c.client.ChangePassword(ctx, req)
+	if err != nil {
+		return "", "", err
+	}
+	return resp.Message, resp.ConnectionString, nil
+}
+
+// ListUsers returns the registered usernames, authorized by the client's connection string.
+func (c *GoDBClient) ListUsers(ctx context.Context) (_ []string, err error) {
+	defer wrapOpError(&err, "ListUsers", "", proto.DatabaseService_ListUsers_FullMethodName, time.Now())
+	req := &proto.ListUsersRequest{ConnectionString: c.connectionStringFor(ctx)}
+	resp, err := c.client.ListUsers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Usernames, nil
+}
+
+// GrantDatabaseAccess gives username access to the named database, which must be
+// owned by the user of the client's connection string.
+func (c *GoDBClient) GrantDatabaseAccess(ctx context.Context, database, username string) (_ string, err error) {
+	defer wrapOpError(&err, "GrantDatabaseAccess", "", proto.DatabaseService_GrantDatabaseAccess_FullMethodName, time.Now())
+	req := &proto.GrantDatabaseAccessRequest{
+		ConnectionString: withDatabaseName(c.connectionStringFor(ctx), database),
+		Username:         username,
+	}
+	resp, err := c.client.GrantDatabaseAccess(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Message, nil
+}
+
 // CreateDatabase creates a new database for a user.
-func (c *GoDBClient) CreateDatabase(ctx context.Context, connectionString string) (string, error) {
+func (c *GoDBClient) CreateDatabase(ctx context.Context, connectionString string) (_ string, err error) {
+	defer wrapOpError(&err, "CreateDatabase", "", proto.DatabaseService_CreateDatabase_FullMethodName, time.Now())
 	req := &proto.CreateDatabaseRequest{ConnectionString: connectionString}
 	resp, err := c.client.CreateDatabase(ctx, req)
 	if err != nil {
@@ -64,8 +307,56 @@ func (c *GoDBClient) CreateDatabase(ctx context.Context, connectionString string
 	return resp.Message, nil
 }
 
+// DropDatabase deletes the named database, using the credentials of the client's
+// connection string. Unless force is set the server refuses to drop a database
+// that still has tables.
+func (c *GoDBClient) DropDatabase(ctx context.Context, name string, force bool) (_ string, err error) {
+	defer wrapOpError(&err, "DropDatabase", "", proto.DatabaseService_DropDatabase_FullMethodName, time.Now())
+	req := &proto.DropDatabaseRequest{
+		ConnectionString: withDatabaseName(c.connectionStringFor(ctx), name),
+		Force:            force,
+	}
+	resp, err := c.client.DropDatabase(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if c.cache != nil {
+		// Cached results must not outlive the database or leak into one
+		// recreated under the same name.
+		c.cache.invalidate(ctx, connGenKey(req.ConnectionString))
+	}
+	return resp.Message, nil
+}
+
+// ListDatabases returns the names of the databases owned by the user of the
+// client's connection string.
+func (c *GoDBClient) ListDatabases(ctx context.Context) (_ []string, err error) {
+	defer wrapOpError(&err, "ListDatabases", "", proto.DatabaseService_ListDatabases_FullMethodName, time.Now())
+	req := &proto.ListDatabasesRequest{ConnectionString: c.connectionStringFor(ctx)}
+	resp, err := c.client.ListDatabases(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Databases, nil
+}
+
+// ListTables returns the names of the tables in the client's database, sorted.
+func (c *GoDBClient) ListTables(ctx context.Context) ([]string, error) {
+	return c.listTables(ctx, c.connectionStringFor(ctx))
+}
+
+func (c *GoDBClient) listTables(ctx context.Context, connStr string) (_ []string, err error) {
+	defer wrapOpError(&err, "ListTables", "", proto.DatabaseService_ListTables_FullMethodName, time.Now())
+	resp, err := c.client.ListTables(ctx, &proto.ListTablesRequest{ConnectionString: connStr})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tables, nil
+}
+
 // CreateTable creates a new table in the specified user database.
-func (c *GoDBClient) CreateTable(ctx context.Context, tableName string, columns map[string]string, connectionString string) (string, error) {
+func (c *GoDBClient) CreateTable(ctx context.Context, tableName string, columns map[string]string, connectionString string) (_ string, err error) {
+	defer wrapOpError(&err, "CreateTable", tableName, proto.DatabaseService_CreateTable_FullMethodName, time.Now())
 	req := &proto.CreateTableRequest{
 		TableName:        tableName,
 		Columns:          columns,
@@ -82,18 +373,20 @@ func (c *GoDBClient) CreateTable(ctx context.Context, tableName string, columns
 
 // UpdateTableBuilder provides a fluent interface for updating table structure.
 type UpdateTableBuilder struct {
-	client     *GoDBClient
-	ctx        context.Context
-	tableName  string
-	columnName string
-	columnType string
+	client           *GoDBClient
+	ctx              context.Context
+	connectionString string
+	tableName        string
+	changes          []*proto.ColumnChange
+	timeout          time.Duration
 }
 
 // NewUpdateTable creates a new UpdateTableBuilder using the client's stored connection string.
 func (client *GoDBClient) UpdateTable(ctx context.Context) *UpdateTableBuilder {
 	return &UpdateTableBuilder{
-		client: client,
-		ctx:    ctx,
+		client:           client,
+		ctx:              ctx,
+		connectionString: client.connectionStringFor(ctx),
 	}
 }
 
@@ -103,48 +396,132 @@ func (utb *UpdateTableBuilder) Table(table string) *UpdateTableBuilder {
 	return utb
 }
 
-// AddColumn sets the new column name and type.
+// AddColumn adds a column of the given type.
 func (utb *UpdateTableBuilder) AddColumn(name, colType string) *UpdateTableBuilder {
-	utb.columnName = name
-	utb.columnType = colType
+	return utb.change(proto.ColumnChangeKind_COLUMN_CHANGE_ADD, name, colType, "")
+}
+
+// DropColumn removes a column.
+func (utb *UpdateTableBuilder) DropColumn(name string) *UpdateTableBuilder {
+	return utb.change(proto.ColumnChangeKind_COLUMN_CHANGE_DROP, name, "", "")
+}
+
+// RenameColumn renames column oldName to newName.
+func (utb *UpdateTableBuilder) RenameColumn(oldName, newName string) *UpdateTableBuilder {
+	return utb.change(proto.ColumnChangeKind_COLUMN_CHANGE_RENAME, oldName, "", newName)
+}
+
+// ModifyColumn changes the type of a column.
+func (utb *UpdateTableBuilder) ModifyColumn(name, newType string) *UpdateTableBuilder {
+	return utb.change(proto.ColumnChangeKind_COLUMN_CHANGE_MODIFY, name, newType, "")
+}
+
+// change queues a column change.
+func (utb *UpdateTableBuilder) change(kind proto.ColumnChangeKind, name, colType, newName string) *UpdateTableBuilder {
+	utb.changes = append(utb.changes, &proto.ColumnChange{
+		Kind:       kind,
+		ColumnName: name,
+		ColumnType: colType,
+		NewName:    newName,
+	})
 	return utb
 }
 
-// Exec executes the update table operation.
+// Timeout sets a deadline for this operation, overriding the client's default timeout.
+func (utb *UpdateTableBuilder) Timeout(d time.Duration) *UpdateTableBuilder {
+	utb.timeout = d
+	return utb
+}
+
+// Exec applies all queued column changes atomically in one request.
 func (utb *UpdateTableBuilder) Exec() (string, error) {
+	resp, err := utb.exec()
+	if err != nil {
+		return "", err
+	}
+	return resp.Message, nil
+}
+
+// ExecResults applies all queued column changes in one request and returns the
+// outcome of each, in the order they were added. The changes are atomic: if
+// one fails, none are applied.
+func (utb *UpdateTableBuilder) ExecResults() ([]*proto.ColumnChangeResult, error) {
+	resp, err := utb.exec()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// exec sends the queued changes.
+func (utb *UpdateTableBuilder) exec() (_ *proto.UpdateTableResponse, err error) {
+	defer wrapOpError(&err, "UpdateTable", utb.tableName, proto.DatabaseService_UpdateTable_FullMethodName, time.Now())
 	if utb.tableName == "" {
-		return "", fmt.Errorf("table name is required")
+		return nil, fmt.Errorf("table name is required")
+	}
+	if len(utb.changes) == 0 {
+		return nil, fmt.Errorf("at least one column change is required")
 	}
-	if utb.columnName == "" || utb.columnType == "" {
-		return "", fmt.Errorf("column name and type are required")
+	for _, ch := range utb.changes {
+		if err := validateColumnChange(ch); err != nil {
+			return nil, err
+		}
 	}
 	req := &proto.UpdateTableRequest{
 		TableName:        utb.tableName,
-		ColumnName:       utb.columnName,
-		ColumnType:       utb.columnType,
-		ConnectionString: utb.client.connectionString,
+		ConnectionString: utb.connectionString,
+		Changes:          utb.changes,
+		Atomic:           true,
 	}
-	resp, err := utb.client.client.UpdateTable(utb.ctx, req)
-	if err != nil {
-		return "", err
+	if len(utb.changes) == 1 && utb.changes[0].Kind == proto.ColumnChangeKind_COLUMN_CHANGE_ADD {
+		// Keep single-column adds readable by servers that predate Changes.
+		req.ColumnName = utb.changes[0].ColumnName
+		req.ColumnType = utb.changes[0].ColumnType
 	}
-	return resp.Message, nil
+	ctx, cancel := withTimeout(utb.ctx, utb.timeout)
+	defer cancel()
+	return utb.client.client.UpdateTable(ctx, req)
+}
+
+// validateColumnChange checks that a change has the fields its kind needs.
+func validateColumnChange(ch *proto.ColumnChange) error {
+	if ch.ColumnName == "" {
+		return fmt.Errorf("column name is required")
+	}
+	switch ch.Kind {
+	case proto.ColumnChangeKind_COLUMN_CHANGE_ADD, proto.ColumnChangeKind_COLUMN_CHANGE_MODIFY:
+		if ch.ColumnType == "" {
+			return fmt.Errorf("column type is required for %q", ch.ColumnName)
+		}
+	case proto.ColumnChangeKind_COLUMN_CHANGE_RENAME:
+		if ch.NewName == "" {
+			return fmt.Errorf("new name is required to rename %q", ch.ColumnName)
+		}
+	}
+	return nil
 }
 
 // InsertBuilder provides a fluent interface for building an insert operation.
 type InsertBuilder struct {
-	client    *GoDBClient
-	ctx       context.Context
-	tableName string
-	record    map[string]string
+	client           *GoDBClient
+	ctx              context.Context
+	connectionString string
+	tableName        string
+	record           map[string]string
+	typed            map[string]*proto.Value
+	ttl              time.Duration
+	returning        []string
+	err              error
+	timeout          time.Duration
 }
 
 // Insert returns a new InsertBuilder using the client's stored connection string.
 func (client *GoDBClient) Insert(ctx context.Context) *InsertBuilder {
 	return &InsertBuilder{
-		client: client,
-		ctx:    ctx,
-		record: make(map[string]string),
+		client:           client,
+		ctx:              ctx,
+		connectionString: client.connectionStringFor(ctx),
+		record:           make(map[string]string),
 	}
 }
 
@@ -157,45 +534,145 @@ func (ib *InsertBuilder) Table(table string) *InsertBuilder {
 // Values sets the record values.
 func (ib *InsertBuilder) Values(record map[string]string) *InsertBuilder {
 	ib.record = record
+	ib.typed = nil
+	return ib
+}
+
+// Model sets the record values from a struct whose fields carry `godb:"column"` tags.
+// Nil pointer fields and zero-valued fields tagged omitempty are skipped.
+func (ib *InsertBuilder) Model(model interface{}) *InsertBuilder {
+	var typed map[string]*proto.Value
+	record, err := guarded(ib.client, func() (record map[string]string, err error) {
+		record, typed, err = encodeModel(ib.client.recordCodec(), model)
+		return record, err
+	})
+	if err != nil {
+		ib.err = err
+		return ib
+	}
+	ib.record, ib.typed = record, typed
+	return ib
+}
+
+// Set sets a single column value, encoding it with the type registry. Numbers,
+// booleans, byte slices, and times are also sent as typed values; nil or Null
+// writes NULL.
+func (ib *InsertBuilder) Set(column string, value interface{}) *InsertBuilder {
+	if ib.record == nil {
+		ib.record = make(map[string]string)
+	}
+	if ib.typed == nil {
+		ib.typed = make(map[string]*proto.Value)
+	}
+	if err := setColumn(ib.client, ib.record, ib.typed, column, value); err != nil {
+		ib.err = err
+	}
+	return ib
+}
+
+// TTL makes the server delete the row once d has passed since the insert,
+// rounded up to whole seconds. Useful for tokens, one-time codes and caches.
+func (ib *InsertBuilder) TTL(d time.Duration) *InsertBuilder {
+	ib.ttl = d
+	return ib
+}
+
+// Returning names columns of the inserted row, such as generated ids,
+// defaults, and timestamps, to return in the Result of ExecResult.
+func (ib *InsertBuilder) Returning(columns ...string) *InsertBuilder {
+	ib.returning = append(ib.returning, columns...)
+	return ib
+}
+
+// Timeout sets a deadline for this operation, overriding the client's default timeout.
+func (ib *InsertBuilder) Timeout(d time.Duration) *InsertBuilder {
+	ib.timeout = d
 	return ib
 }
 
 // Exec executes the insert operation.
 func (ib *InsertBuilder) Exec() (string, error) {
+	resp, err := ib.exec()
+	if err != nil {
+		return "", err
+	}
+	return resp.Message, nil
+}
+
+// ExecResult executes the insert operation and reports its effect.
+func (ib *InsertBuilder) ExecResult() (Result, error) {
+	resp, err := ib.exec()
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{RowsAffected: resp.RowsAffected, InsertedIDs: insertedIDs(resp.InsertedId), Message: resp.Message, Returned: resp.Returned}, nil
+}
+
+// ExecID executes the insert operation and returns the id the server reported
+// for the row, such as one generated for an AutoID column.
+func (ib *InsertBuilder) ExecID() (string, error) {
+	resp, err := ib.exec()
+	if err != nil {
+		return "", err
+	}
+	if resp.InsertedId == "" {
+		return "", fmt.Errorf("server reported no inserted id for table %s", ib.tableName)
+	}
+	return resp.InsertedId, nil
+}
+
+// exec sends the InsertRecord request.
+func (ib *InsertBuilder) exec() (_ *proto.InsertRecordResponse, err error) {
+	defer wrapOpError(&err, "Insert", ib.tableName, proto.DatabaseService_InsertRecord_FullMethodName, time.Now())
+	if ib.err != nil {
+		return nil, ib.err
+	}
 	if ib.tableName == "" {
-		return "", fmt.Errorf("table name is required")
+		return nil, fmt.Errorf("table name is required")
 	}
-	if ib.record == nil || len(ib.record) == 0 {
-		return "", fmt.Errorf("no record provided")
+	if len(ib.record) == 0 {
+		return nil, fmt.Errorf("no record provided")
 	}
+	if ib.ttl < 0 {
+		return nil, fmt.Errorf("ttl must not be negative")
+	}
+	record, typed := extractNulls(ib.record, ib.typed)
 	// Construct the request directly.
 	req := &proto.InsertRecordRequest{
 		TableName:        ib.tableName,
-		Record:           ib.record,
-		ConnectionString: ib.client.connectionString,
+		Record:           record,
+		ConnectionString: ib.connectionString,
+		TtlSeconds:       int64((ib.ttl + time.Second - 1) / time.Second),
+		TypedRecord:      typed,
+		Returning:        ib.returning,
 	}
 	// Directly call the gRPC method on the underlying client.
-	resp, err := ib.client.client.InsertRecord(ib.ctx, req)
-	if err != nil {
-		return "", err
-	}
-	return resp.Message, nil
+	ctx, cancel := withTimeout(ib.ctx, ib.timeout)
+	defer cancel()
+	return ib.client.client.InsertRecord(ctx, req)
 }
 
 // InsertMultipleBuilder provides a fluent interface for inserting multiple records.
 type InsertMultipleBuilder struct {
-	client    *GoDBClient
-	ctx       context.Context
-	tableName string
-	records   []*proto.Record
+	client           *GoDBClient
+	ctx              context.Context
+	connectionString string
+	tableName        string
+	records          []*proto.Record
+	batchSize        int
+	returning        []string
+	err              error
+	timeout          time.Duration
 }
 
 // NewInsertMultiple returns a new InsertMultipleBuilder using the client's stored connection string.
 func (client *GoDBClient) InsertMultiple(ctx context.Context) *InsertMultipleBuilder {
 	return &InsertMultipleBuilder{
-		client:  client,
-		ctx:     ctx,
-		records: make([]*proto.Record, 0),
+		client:           client,
+		ctx:              ctx,
+		connectionString: client.connectionStringFor(ctx),
+		records:          make([]*proto.Record, 0),
+		batchSize:        client.insertBatchSize,
 	}
 }
 
@@ -208,30 +685,119 @@ func (imb *InsertMultipleBuilder) Table(table string) *InsertMultipleBuilder {
 // Records sets multiple records at once.
 func (imb *InsertMultipleBuilder) Records(records []map[string]string) *InsertMultipleBuilder {
 	for _, rec := range records {
-		imb.records = append(imb.records, &proto.Record{Data: rec})
+		data, typed := extractNulls(rec, nil)
+		imb.records = append(imb.records, &proto.Record{Data: data, TypedData: typed})
+	}
+	return imb
+}
+
+// Row adds one record of column values, encoded as by InsertBuilder.Set.
+func (imb *InsertMultipleBuilder) Row(values map[string]interface{}) *InsertMultipleBuilder {
+	data := make(map[string]string, len(values))
+	typed := make(map[string]*proto.Value)
+	for col, v := range values {
+		if err := setColumn(imb.client, data, typed, col, v); err != nil {
+			imb.err = fmt.Errorf("column %s: %w", col, err)
+			return imb
+		}
 	}
+	data, typed = extractNulls(data, typed)
+	imb.records = append(imb.records, &proto.Record{Data: data, TypedData: typed})
+	return imb
+}
+
+// Models adds one record per tagged struct, encoded as by InsertBuilder.Model.
+func (imb *InsertMultipleBuilder) Models(models ...interface{}) *InsertMultipleBuilder {
+	for _, model := range models {
+		var typed map[string]*proto.Value
+		record, err := guarded(imb.client, func() (record map[string]string, err error) {
+			record, typed, err = encodeModel(imb.client.recordCodec(), model)
+			return record, err
+		})
+		if err != nil {
+			imb.err = err
+			return imb
+		}
+		imb.records = append(imb.records, &proto.Record{Data: record, TypedData: typed})
+	}
+	return imb
+}
+
+// BatchSize splits the insert into requests of at most n records, overriding the
+// client's WithInsertBatchSize. Batches are not atomic: if one fails, earlier
+// batches stay inserted. Zero sends all records in one request.
+func (imb *InsertMultipleBuilder) BatchSize(n int) *InsertMultipleBuilder {
+	imb.batchSize = n
+	return imb
+}
+
+// Returning names columns of the inserted rows to return in the Result of
+// ExecResult, in record order.
+func (imb *InsertMultipleBuilder) Returning(columns ...string) *InsertMultipleBuilder {
+	imb.returning = append(imb.returning, columns...)
+	return imb
+}
+
+// Timeout sets a deadline for this operation, overriding the client's default timeout.
+func (imb *InsertMultipleBuilder) Timeout(d time.Duration) *InsertMultipleBuilder {
+	imb.timeout = d
 	return imb
 }
 
 // Exec executes the insert operation by directly calling the gRPC InsertMultipleRecords API.
 func (imb *InsertMultipleBuilder) Exec() (string, error) {
+	res, err := imb.ExecResult()
+	if err != nil {
+		return "", err
+	}
+	return res.Message, nil
+}
+
+// ExecResult executes the insert operation and reports its effect, summed over
+// all batches.
+func (imb *InsertMultipleBuilder) ExecResult() (_ Result, err error) {
+	defer wrapOpError(&err, "InsertMultiple", imb.tableName, proto.DatabaseService_InsertMultipleRecords_FullMethodName, time.Now())
+	if imb.err != nil {
+		return Result{}, imb.err
+	}
 	if imb.tableName == "" {
-		return "", fmt.Errorf("table name is required")
+		return Result{}, fmt.Errorf("table name is required")
 	}
 	if len(imb.records) == 0 {
-		return "", fmt.Errorf("no records provided")
+		return Result{}, fmt.Errorf("no records provided")
 	}
 
-	req := &proto.InsertMultipleRecordsRequest{
-		TableName:        imb.tableName,
-		Records:          imb.records,
-		ConnectionString: imb.client.connectionString,
+	ctx, cancel := withTimeout(imb.ctx, imb.timeout)
+	defer cancel()
+	size := imb.batchSize
+	if size <= 0 {
+		size = len(imb.records)
 	}
-	resp, err := imb.client.client.InsertMultipleRecords(imb.ctx, req)
-	if err != nil {
-		return "", err
+	var res Result
+	for start := 0; start < len(imb.records); start += size {
+		end := start + size
+		if end > len(imb.records) {
+			end = len(imb.records)
+		}
+		req := &proto.InsertMultipleRecordsRequest{
+			TableName:        imb.tableName,
+			Records:          imb.records[start:end],
+			ConnectionString: imb.connectionString,
+			Returning:        imb.returning,
+		}
+		resp, err := imb.client.client.InsertMultipleRecords(ctx, req)
+		if err != nil {
+			if start > 0 {
+				return Result{}, fmt.Errorf("batch starting at record %d: %w", start, err)
+			}
+			return Result{}, err
+		}
+		res.RowsAffected += resp.RowsAffected
+		res.InsertedIDs = append(res.InsertedIDs, resp.InsertedIds...)
+		res.Returned = append(res.Returned, resp.Returned...)
+		res.Message = resp.Message
 	}
-	return resp.Message, nil
+	return res, nil
 }
 
 // UpdateRecordBuilder provides a fluent interface for updating records.
@@ -240,8 +806,12 @@ type UpdateRecordBuilder struct {
 	ctx              context.Context
 	tableName        string
 	updates          map[string]string
-	condition        string
+	typed            map[string]*proto.Value
+	conds            []Cond
 	connectionString string
+	returning        []string
+	err              error
+	timeout          time.Duration
 }
 
 // NewUpdateRecord creates a new UpdateRecordBuilder using the client's stored connection string.
@@ -249,7 +819,9 @@ func (client *GoDBClient) UpdateRecord(ctx context.Context) *UpdateRecordBuilder
 	return &UpdateRecordBuilder{
 		client:           client,
 		ctx:              ctx,
+		connectionString: client.connectionStringFor(ctx),
 		updates:          make(map[string]string),
+		typed:            make(map[string]*proto.Value),
 	}
 }
 
@@ -259,97 +831,159 @@ func (urb *UpdateRecordBuilder) Table(table string) *UpdateRecordBuilder {
 	return urb
 }
 
-// SetUpdate sets a key-value update.
+// SetUpdate sets a key-value update. Numbers, booleans, byte slices, and times
+// are also sent as typed values; nil or Null sets the column to NULL.
 func (urb *UpdateRecordBuilder) SetUpdate(field string, value interface{}) *UpdateRecordBuilder {
-	urb.updates[field] = fmt.Sprintf("%v", value)
+	if err := setColumn(urb.client, urb.updates, urb.typed, field, value); err != nil {
+		urb.err = err
+	}
 	return urb
 }
 
 // Updates sets multiple updates at once.
 func (urb *UpdateRecordBuilder) Updates(upds map[string]interface{}) *UpdateRecordBuilder {
 	for k, v := range upds {
-		urb.updates[k] = fmt.Sprintf("%v", v)
+		urb.SetUpdate(k, v)
 	}
 	return urb
 }
 
-// Condition sets a custom WHERE condition.
-func (urb *UpdateRecordBuilder) Condition(cond string) *UpdateRecordBuilder {
-	urb.condition = cond
+// Condition sets a custom WHERE condition, replacing any conditions added so far.
+// Named parameters written :name take their values from params, as with Named.
+func (urb *UpdateRecordBuilder) Condition(cond string, params ...Params) *UpdateRecordBuilder {
+	urb.conds = []Cond{conditionText(cond, params)}
+	return urb
+}
+
+// Where adds a condition expression, ANDed with the existing conditions.
+func (urb *UpdateRecordBuilder) Where(cond Cond) *UpdateRecordBuilder {
+	urb.conds = append(urb.conds, cond)
 	return urb
 }
 
 // Equal adds an equality condition.
 func (urb *UpdateRecordBuilder) Equal(field string, value interface{}) *UpdateRecordBuilder {
-	cond := formatCondition(field, "=", value)
-	urb.addCondition(cond)
-	return urb
+	return urb.Where(Eq(field, value))
 }
 
 // Greater adds a greater-than condition.
 func (urb *UpdateRecordBuilder) Greater(field string, value interface{}) *UpdateRecordBuilder {
-	cond := formatCondition(field, ">", value)
-	urb.addCondition(cond)
-	return urb
+	return urb.Where(Gt(field, value))
 }
 
 // Less adds a less-than condition.
 func (urb *UpdateRecordBuilder) Less(field string, value interface{}) *UpdateRecordBuilder {
-	cond := formatCondition(field, "<", value)
-	urb.addCondition(cond)
-	return urb
+	return urb.Where(Lt(field, value))
 }
 
 // addCondition appends a condition to the builder.
 func (urb *UpdateRecordBuilder) addCondition(cond string) {
-	if urb.condition != "" {
-		urb.condition += " AND " + cond
-	} else {
-		urb.condition = cond
-	}
+	urb.conds = append(urb.conds, rawCond(cond))
+}
+
+// Returning names columns of each updated row, as updated, to return in the
+// Result of ExecResult, such as server-maintained timestamps.
+func (urb *UpdateRecordBuilder) Returning(columns ...string) *UpdateRecordBuilder {
+	urb.returning = append(urb.returning, columns...)
+	return urb
+}
+
+// Timeout sets a deadline for this operation, overriding the client's default timeout.
+func (urb *UpdateRecordBuilder) Timeout(d time.Duration) *UpdateRecordBuilder {
+	urb.timeout = d
+	return urb
 }
 
 // Exec executes the update record operation.
 func (urb *UpdateRecordBuilder) Exec() (string, error) {
+	resp, err := urb.exec(0)
+	if err != nil {
+		return "", err
+	}
+	return resp.Message, nil
+}
+
+// ExecResult executes the update record operation and reports its effect.
+func (urb *UpdateRecordBuilder) ExecResult() (Result, error) {
+	resp, err := urb.exec(0)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{RowsAffected: resp.RowsAffected, Message: resp.Message, Returned: resp.Returned}, nil
+}
+
+// ExecChanges executes the update and returns the before and after images of
+// up to maxRows updated rows, with ChangesTruncated set when more rows were
+// updated.
+func (urb *UpdateRecordBuilder) ExecChanges(maxRows int) (*proto.UpdateRecordResponse, error) {
+	if maxRows <= 0 {
+		return nil, fmt.Errorf("maxRows must be positive")
+	}
+	return urb.exec(maxRows)
+}
+
+// exec sends the UpdateRecord request.
+func (urb *UpdateRecordBuilder) exec(returnChanges int) (_ *proto.UpdateRecordResponse, err error) {
+	defer wrapOpError(&err, "UpdateRecord", urb.tableName, proto.DatabaseService_UpdateRecord_FullMethodName, time.Now())
+	if urb.err != nil {
+		return nil, urb.err
+	}
 	if urb.tableName == "" {
-		return "", fmt.Errorf("table name is required")
+		return nil, fmt.Errorf("table name is required")
 	}
 	if len(urb.updates) == 0 {
-		return "", fmt.Errorf("no updates provided")
+		return nil, fmt.Errorf("no updates provided")
+	}
+	condition, err := guarded(urb.client, func() (string, error) {
+		return renderCond(And(urb.conds...))
+	})
+	if err != nil {
+		return nil, err
 	}
+	updates, typed := extractNulls(urb.updates, urb.typed)
 	req := &proto.UpdateRecordRequest{
 		TableName:        urb.tableName,
-		Updates:          urb.updates,
-		Condition:        urb.condition,
-		ConnectionString: urb.client.connectionString,
+		Updates:          updates,
+		Condition:        condition,
+		ConnectionString: urb.connectionString,
+		TypedUpdates:     typed,
+		ReturnChanges:    int32(returnChanges),
+		Returning:        urb.returning,
 	}
-	resp, err := urb.client.client.UpdateRecord(urb.ctx, req)
-	if err != nil {
-		return "", err
-	}
-	return resp.Message, nil
+	ctx, cancel := withTimeout(urb.ctx, urb.timeout)
+	defer cancel()
+	return urb.client.client.UpdateRecord(ctx, req)
 }
 
 // QueryBuilder provides a fluent interface for building queries.
 type QueryBuilder struct {
-	client    *GoDBClient
-	ctx       context.Context
-	tableName string
-	columns   string
-	condition string
-	orderBy   string
-	limit     int
-	offset    int
-	cursor    string
+	client           *GoDBClient
+	ctx              context.Context
+	connectionString string
+	tableName        string
+	columns          string
+	conds            []Cond
+	groupBy          []string
+	having           string
+	orderBy          string
+	limit            int
+	offset           int
+	cursor           string
+	after            *cursorPos
+	afterValue       interface{}
+	cursorErr        error
+	timeout          time.Duration
+	verify           bool
 }
 
 // Query creates a new QueryBuilder using the client's stored connection string.
 func (client *GoDBClient) Query(ctx context.Context) *QueryBuilder {
 	return &QueryBuilder{
-		client: client,
-		ctx:    ctx,
-		limit:  0,
-		offset: 0,
+		client:           client,
+		ctx:              ctx,
+		connectionString: client.connectionStringFor(ctx),
+		limit:            0,
+		offset:           0,
 	}
 }
 
@@ -365,55 +999,64 @@ func (qb *QueryBuilder) Columns(cols string) *QueryBuilder {
 	return qb
 }
 
-// Condition sets a custom WHERE condition.
-func (qb *QueryBuilder) Condition(cond string) *QueryBuilder {
-	qb.condition = cond
+// Condition sets a custom WHERE condition, replacing any conditions added so far.
+// Named parameters written :name take their values from params, as with Named.
+func (qb *QueryBuilder) Condition(cond string, params ...Params) *QueryBuilder {
+	qb.conds = []Cond{conditionText(cond, params)}
+	return qb
+}
+
+// Where adds a condition expression, ANDed with the existing conditions.
+func (qb *QueryBuilder) Where(cond Cond) *QueryBuilder {
+	qb.conds = append(qb.conds, cond)
 	return qb
 }
 
 // Equal adds an equality condition (e.g., field = value).
 func (qb *QueryBuilder) Equal(field string, value interface{}) *QueryBuilder {
-	condition := formatCondition(field, "=", value)
-	qb.addCondition(condition)
-	return qb
+	return qb.Where(Eq(field, value))
 }
 
 // Greater adds a greater-than condition (e.g., field > value).
 func (qb *QueryBuilder) Greater(field string, value interface{}) *QueryBuilder {
-	condition := formatCondition(field, ">", value)
-	qb.addCondition(condition)
-	return qb
+	return qb.Where(Gt(field, value))
 }
 
 // Less adds a less-than condition (e.g., field < value).
 func (qb *QueryBuilder) Less(field string, value interface{}) *QueryBuilder {
-	condition := formatCondition(field, "<", value)
-	qb.addCondition(condition)
-	return qb
+	return qb.Where(Lt(field, value))
 }
 
 // LessEqual adds a less-than-or-equal condition (e.g., field <= value).
 func (qb *QueryBuilder) LessEqual(field string, value interface{}) *QueryBuilder {
-	condition := formatCondition(field, "<=", value)
-	qb.addCondition(condition)
-	return qb
+	return qb.Where(Lte(field, value))
 }
 
 // addCondition appends a new condition to the builder.
 func (qb *QueryBuilder) addCondition(cond string) {
-	if qb.condition != "" {
-		qb.condition += " AND " + cond
-	} else {
-		qb.condition = cond
-	}
+	qb.conds = append(qb.conds, rawCond(cond))
 }
 
-// Cursor sets a cursor for pagination. It will add a condition like "id > {cursor}".
+// Cursor sets a cursor for pagination. It will add a condition like "id > {cursor}",
+// quoting non-numeric ids. See CursorAfter for other columns and directions and
+// Paginate for a page-at-a-time API.
 func (qb *QueryBuilder) Cursor(cursor string) *QueryBuilder {
 	qb.cursor = cursor
 	return qb
 }
 
+// GroupBy sets the GROUP BY columns.
+func (qb *QueryBuilder) GroupBy(cols ...string) *QueryBuilder {
+	qb.groupBy = append(qb.groupBy, cols...)
+	return qb
+}
+
+// Having sets the HAVING condition applied to grouped rows.
+func (qb *QueryBuilder) Having(cond string) *QueryBuilder {
+	qb.having = cond
+	return qb
+}
+
 // OrderBy sets the ORDER BY clause.
 func (qb *QueryBuilder) OrderBy(order string) *QueryBuilder {
 	qb.orderBy = order
@@ -432,26 +1075,51 @@ func (qb *QueryBuilder) Offset(offset int) *QueryBuilder {
 	return qb
 }
 
+// Timeout sets a deadline for this operation, overriding the client's default timeout.
+func (qb *QueryBuilder) Timeout(d time.Duration) *QueryBuilder {
+	qb.timeout = d
+	return qb
+}
+
 // Exec constructs the QueryDataRequest and directly calls the gRPC QueryData API.
-func (qb *QueryBuilder) Exec() (*proto.QueryDataResponse, error) {
-	// Build conditions.
-	var conditions []string
-	if qb.condition != "" {
-		conditions = append(conditions, qb.condition)
+func (qb *QueryBuilder) Exec() (_ *proto.QueryDataResponse, err error) {
+	defer wrapOpError(&err, "Query", qb.tableName, proto.DatabaseService_QueryData_FullMethodName, time.Now())
+	req, err := qb.request()
+	if err != nil {
+		return nil, err
 	}
-	// If cursor is provided, add a condition for pagination.
-	if qb.cursor != "" {
-		conditions = append(conditions, fmt.Sprintf("id > %s", qb.cursor))
+	ctx, cancel := withTimeout(qb.ctx, qb.timeout)
+	defer cancel()
+	if qb.verify {
+		return qb.client.verifiedQuery(ctx, req, qb.orderBy != "")
 	}
+	return qb.client.client.QueryData(ctx, req)
+}
 
-	finalCondition := ""
-	if len(conditions) > 0 {
-		finalCondition = strings.Join(conditions, " AND ")
+// request builds the QueryDataRequest for the query.
+func (qb *QueryBuilder) request() (*proto.QueryDataRequest, error) {
+	if qb.having != "" && len(qb.groupBy) == 0 {
+		return nil, fmt.Errorf("having requires group by")
+	}
+	finalCondition, err := qb.where()
+	if err != nil {
+		return nil, err
+	}
+	orderBy := qb.orderBy
+	if orderBy == "" && qb.after != nil {
+		orderBy = qb.after.order()
 	}
 
+	// Append GROUP BY and HAVING clauses if provided.
+	if len(qb.groupBy) > 0 {
+		finalCondition += " GROUP BY " + strings.Join(qb.groupBy, ", ")
+	}
+	if qb.having != "" {
+		finalCondition += " HAVING " + qb.having
+	}
 	// Append ORDER BY clause if provided.
-	if qb.orderBy != "" {
-		finalCondition += " ORDER BY " + qb.orderBy
+	if orderBy != "" {
+		finalCondition += " ORDER BY " + orderBy
 	}
 	// Append LIMIT and OFFSET.
 	if qb.limit > 0 {
@@ -461,35 +1129,98 @@ func (qb *QueryBuilder) Exec() (*proto.QueryDataResponse, error) {
 		finalCondition += " OFFSET " + strconv.Itoa(qb.offset)
 	}
 
-	req := &proto.QueryDataRequest{
-		ConnectionString: qb.client.connectionString,
+	return &proto.QueryDataRequest{
+		ConnectionString: qb.connectionString,
 		TableName:        qb.tableName,
 		Columns:          qb.columns,
 		Condition:        finalCondition,
+	}, nil
+}
+
+// where renders the query's conditions, including the cursor, without the
+// GROUP BY, ORDER BY, and LIMIT clauses.
+func (qb *QueryBuilder) where() (string, error) {
+	// Build conditions.
+	condition, err := guarded(qb.client, func() (string, error) {
+		return renderCond(And(qb.conds...))
+	})
+	if err != nil {
+		return "", err
+	}
+	var conditions []string
+	if condition != "" {
+		conditions = append(conditions, groupOr(condition))
 	}
-	return qb.client.client.QueryData(qb.ctx, req)
+	// If cursor is provided, add a condition for pagination.
+	if qb.cursor != "" {
+		conditions = append(conditions, "id > "+cursorLiteral(qb.cursor))
+	}
+	pos, err := qb.resolveCursor()
+	if err != nil {
+		return "", err
+	}
+	if pos != nil {
+		conditions = append(conditions, pos.condition())
+	}
+	return strings.Join(conditions, " AND "), nil
 }
 
 // formatCondition formats the condition based on the operator and value.
 // If the value is a string, it adds quotes around it.
-func formatCondition(field, operator string, value interface{}) string {
+// Values of registered types are encoded with their codec first.
+func formatCondition(field, operator string, value interface{}) (string, error) {
+	literal, err := formatLiteral(value)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s %s", field, operator, literal), nil
+}
+
+// formatLiteral renders a value as a condition operand.
+func formatLiteral(value interface{}) (string, error) {
+	if isNull(value) {
+		return "NULL", nil
+	}
+	if value != nil {
+		if c, ok := lookupCodec(reflect.TypeOf(value)); ok {
+			encoded, err := encodeValue(value)
+			if err != nil {
+				return "", err
+			}
+			if c.unquoted {
+				return encoded, nil
+			}
+			return quoteString(encoded), nil
+		}
+	}
 	switch v := value.(type) {
 	case string:
-		escaped := strings.ReplaceAll(v, "'", "''")
-		return fmt.Sprintf("%s %s '%s'", field, operator, escaped)
+		return quoteString(v), nil
+	case time.Time:
+		return quoteString(formatTime(v)), nil
 	default:
-		return fmt.Sprintf("%s %s %v", field, operator, v)
+		return fmt.Sprintf("%v", v), nil
 	}
 }
 
+// quoteString wraps s in single quotes, escaping embedded quotes.
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
 // AddIndex creates an index on a table.
-func (c *GoDBClient) AddIndex(ctx context.Context, tableName, indexName string, columns []string, connectionString string) (string, error) {
-	req := &proto.AddIndexRequest{
+func (c *GoDBClient) AddIndex(ctx context.Context, tableName, indexName string, columns []string, connectionString string) (_ string, err error) {
+	defer wrapOpError(&err, "AddIndex", tableName, proto.DatabaseService_AddIndex_FullMethodName, time.Now())
+	return c.addIndex(ctx, &proto.AddIndexRequest{
 		TableName:        tableName,
 		IndexName:        indexName,
 		Columns:          columns,
 		ConnectionString: connectionString,
-	}
+	})
+}
+
+// addIndex sends an AddIndex request.
+func (c *GoDBClient) addIndex(ctx context.Context, req *proto.AddIndexRequest) (string, error) {
 	resp, err := c.client.AddIndex(ctx, req)
 	if err != nil {
 		return "", err
@@ -498,7 +1229,8 @@ func (c *GoDBClient) AddIndex(ctx context.Context, tableName, indexName string,
 }
 
 // DeleteIndex deletes an index from a table.
-func (c *GoDBClient) DeleteIndex(ctx context.Context, indexName, connectionString string) (string, error) {
+func (c *GoDBClient) DeleteIndex(ctx context.Context, indexName, connectionString string) (_ string, err error) {
+	defer wrapOpError(&err, "DeleteIndex", "", proto.DatabaseService_DeleteIndex_FullMethodName, time.Now())
 	req := &proto.DeleteIndexRequest{
 		IndexName:        indexName,
 		ConnectionString: connectionString,
@@ -511,9 +1243,27 @@ func (c *GoDBClient) DeleteIndex(ctx context.Context, indexName, connectionStrin
 }
 
 // ListIndexes lists all indexes for a given user's database.
-func (c *GoDBClient) ListIndexes(ctx context.Context, connectionString string) (*proto.ListIndexesResponse, error) {
+func (c *GoDBClient) ListIndexes(ctx context.Context, connectionString string) (_ *proto.ListIndexesResponse, err error) {
+	defer wrapOpError(&err, "ListIndexes", "", proto.DatabaseService_ListIndexes_FullMethodName, time.Now())
 	req := &proto.ListIndexesRequest{
 		ConnectionString: connectionString,
 	}
 	return c.client.ListIndexes(ctx, req)
 }
+
+// DescribeTable returns the columns of a table, reporting whether the table exists.
+func (c *GoDBClient) DescribeTable(ctx context.Context, tableName, connectionString string) (_ *proto.DescribeTableResponse, err error) {
+	defer wrapOpError(&err, "DescribeTable", tableName, proto.DatabaseService_DescribeTable_FullMethodName, time.Now())
+	req := &proto.DescribeTableRequest{
+		TableName:        tableName,
+		ConnectionString: connectionString,
+	}
+	resp, err := c.client.DescribeTable(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkSchemaPin(connectionString, tableName, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
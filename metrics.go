@@ -0,0 +1,312 @@
+package godb
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// latencyBuckets are the upper bounds, in seconds, of the latency histogram.
+var latencyBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// MetricsCollector records per-RPC latency histograms, error counts, retries,
+// in-flight requests, connection health, and pool utilization for a client. It
+// writes them in the Prometheus text exposition format and can be mounted
+// directly as a scrape endpoint, e.g. http.Handle("/metrics", client.Collector()).
+// To register them with a Prometheus registry instead, wrap the collector with
+// the godbprom module's NewCollector.
+type MetricsCollector struct {
+	state func() State
+
+	mu                 sync.Mutex
+	methods            map[string]*methodMetrics
+	inFlight           int64
+	connectionFailures int64
+	retries            map[string]int64
+	pool               func() PoolStats
+}
+
+// MetricsSnapshot is a copy of a client's metrics at one point in time.
+type MetricsSnapshot struct {
+	Methods            map[string]MethodMetrics // by full RPC method name
+	Retries            map[string]int64         // by full RPC method name
+	InFlight           int64
+	ConnectionFailures int64
+	State              State
+	// Pool is nil unless the client was created with WithMaxConcurrentStreams.
+	Pool *PoolStats
+}
+
+// MethodMetrics are the latency histogram and error counts of one RPC method.
+type MethodMetrics struct {
+	// Buckets holds the number of calls that took at most the matching bound
+	// of LatencyBuckets, cumulatively as in a Prometheus histogram.
+	Buckets []uint64
+	Count   uint64
+	Sum     float64           // total latency in seconds
+	Errors  map[string]uint64 // by gRPC status code name
+}
+
+// PoolStats describes the utilization of a client's connection pool.
+type PoolStats struct {
+	Connections   int // open connections, including the primary
+	ActiveStreams int // calls and streams in progress over them
+	MaxStreams    int // the cap on active streams per connection
+}
+
+// Utilization returns the share of the pool's stream capacity in use.
+func (p PoolStats) Utilization() float64 {
+	if p.Connections == 0 || p.MaxStreams == 0 {
+		return 0
+	}
+	return float64(p.ActiveStreams) / float64(p.Connections*p.MaxStreams)
+}
+
+// LatencyBuckets returns the upper bounds, in seconds, of the latency histogram.
+func LatencyBuckets() []float64 {
+	return append([]float64(nil), latencyBuckets...)
+}
+
+// methodMetrics holds the series for one RPC method.
+type methodMetrics struct {
+	buckets []uint64
+	count   uint64
+	sum     float64
+	errors  map[string]uint64
+}
+
+// newMetricsCollector returns an empty collector reporting the connection state via state.
+func newMetricsCollector(state func() State) *MetricsCollector {
+	return &MetricsCollector{
+		state:   state,
+		methods: make(map[string]*methodMetrics),
+		retries: make(map[string]int64),
+	}
+}
+
+// Collector returns the client's metrics.
+func (c *GoDBClient) Collector() *MetricsCollector {
+	return c.watcher.metrics
+}
+
+// unaryInterceptor times each call and counts its errors.
+func (m *MetricsCollector) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	done := m.begin()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	done(method, err)
+	return err
+}
+
+// streamInterceptor times the opening of each stream and counts its errors.
+func (m *MetricsCollector) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	done := m.begin()
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	done(method, err)
+	return stream, err
+}
+
+// begin marks a request in flight and returns a function recording its outcome.
+func (m *MetricsCollector) begin() func(method string, err error) {
+	start := time.Now()
+	m.mu.Lock()
+	m.inFlight++
+	m.mu.Unlock()
+	return func(method string, err error) {
+		elapsed := time.Since(start).Seconds()
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.inFlight--
+		mm, ok := m.methods[method]
+		if !ok {
+			mm = &methodMetrics{
+				buckets: make([]uint64, len(latencyBuckets)),
+				errors:  make(map[string]uint64),
+			}
+			m.methods[method] = mm
+		}
+		mm.count++
+		mm.sum += elapsed
+		for i, le := range latencyBuckets {
+			if elapsed <= le {
+				mm.buckets[i]++
+			}
+		}
+		if err != nil {
+			mm.errors[status.Code(err).String()]++
+		}
+	}
+}
+
+// connectionFailed counts a failed attempt to reach the server.
+func (m *MetricsCollector) connectionFailed() {
+	m.mu.Lock()
+	m.connectionFailures++
+	m.mu.Unlock()
+}
+
+// retried counts a retry of method.
+func (m *MetricsCollector) retried(method string) {
+	m.mu.Lock()
+	m.retries[method]++
+	m.mu.Unlock()
+}
+
+// setPool makes the collector report the utilization of a connection pool.
+func (m *MetricsCollector) setPool(stats func() PoolStats) {
+	m.mu.Lock()
+	m.pool = stats
+	m.mu.Unlock()
+}
+
+// Snapshot returns a copy of the current metrics.
+func (m *MetricsCollector) Snapshot() *MetricsSnapshot {
+	m.mu.Lock()
+	snap := &MetricsSnapshot{
+		Methods:            make(map[string]MethodMetrics, len(m.methods)),
+		Retries:            make(map[string]int64, len(m.retries)),
+		InFlight:           m.inFlight,
+		ConnectionFailures: m.connectionFailures,
+	}
+	for method, mm := range m.methods {
+		errs := make(map[string]uint64, len(mm.errors))
+		for code, n := range mm.errors {
+			errs[code] = n
+		}
+		snap.Methods[method] = MethodMetrics{
+			Buckets: append([]uint64(nil), mm.buckets...),
+			Count:   mm.count,
+			Sum:     mm.sum,
+			Errors:  errs,
+		}
+	}
+	for method, n := range m.retries {
+		snap.Retries[method] = n
+	}
+	pool := m.pool
+	m.mu.Unlock()
+
+	snap.State = m.state()
+	if pool != nil {
+		stats := pool()
+		snap.Pool = &stats
+	}
+	return snap
+}
+
+// WriteTo writes all metrics in the Prometheus text exposition format.
+func (m *MetricsCollector) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+	snap := m.Snapshot()
+	methods := make([]string, 0, len(snap.Methods))
+	for method := range snap.Methods {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	fmt.Fprintln(cw, "# HELP godb_request_duration_seconds Latency of GoDB RPCs.")
+	fmt.Fprintln(cw, "# TYPE godb_request_duration_seconds histogram")
+	for _, method := range methods {
+		mm := snap.Methods[method]
+		for i, le := range latencyBuckets {
+			fmt.Fprintf(cw, "godb_request_duration_seconds_bucket{method=%q,le=%q} %d\n", method, strconv.FormatFloat(le, 'g', -1, 64), mm.Buckets[i])
+		}
+		fmt.Fprintf(cw, "godb_request_duration_seconds_bucket{method=%q,le=\"+Inf\"} %d\n", method, mm.Count)
+		fmt.Fprintf(cw, "godb_request_duration_seconds_sum{method=%q} %g\n", method, mm.Sum)
+		fmt.Fprintf(cw, "godb_request_duration_seconds_count{method=%q} %d\n", method, mm.Count)
+	}
+
+	fmt.Fprintln(cw, "# HELP godb_request_errors_total Failed GoDB RPCs by gRPC status code.")
+	fmt.Fprintln(cw, "# TYPE godb_request_errors_total counter")
+	for _, method := range methods {
+		errs := snap.Methods[method].Errors
+		codes := make([]string, 0, len(errs))
+		for code := range errs {
+			codes = append(codes, code)
+		}
+		sort.Strings(codes)
+		for _, code := range codes {
+			fmt.Fprintf(cw, "godb_request_errors_total{method=%q,code=%q} %d\n", method, code, errs[code])
+		}
+	}
+
+	fmt.Fprintln(cw, "# HELP godb_request_retries_total Retried GoDB RPCs.")
+	fmt.Fprintln(cw, "# TYPE godb_request_retries_total counter")
+	retried := make([]string, 0, len(snap.Retries))
+	for method := range snap.Retries {
+		retried = append(retried, method)
+	}
+	sort.Strings(retried)
+	for _, method := range retried {
+		fmt.Fprintf(cw, "godb_request_retries_total{method=%q} %d\n", method, snap.Retries[method])
+	}
+
+	fmt.Fprintln(cw, "# HELP godb_requests_in_flight GoDB RPCs currently in progress.")
+	fmt.Fprintln(cw, "# TYPE godb_requests_in_flight gauge")
+	fmt.Fprintf(cw, "godb_requests_in_flight %d\n", snap.InFlight)
+
+	fmt.Fprintln(cw, "# HELP godb_connection_failures_total Failed attempts to connect to the GoDB server.")
+	fmt.Fprintln(cw, "# TYPE godb_connection_failures_total counter")
+	fmt.Fprintf(cw, "godb_connection_failures_total %d\n", snap.ConnectionFailures)
+
+	fmt.Fprintln(cw, "# HELP godb_connection_state Current connection state (1 for the active state).")
+	fmt.Fprintln(cw, "# TYPE godb_connection_state gauge")
+	for s := StateIdle; s <= StateShutdown; s++ {
+		v := 0
+		if s == snap.State {
+			v = 1
+		}
+		fmt.Fprintf(cw, "godb_connection_state{state=%q} %d\n", s, v)
+	}
+
+	if p := snap.Pool; p != nil {
+		fmt.Fprintln(cw, "# HELP godb_pool_connections Open connections in the client's pool.")
+		fmt.Fprintln(cw, "# TYPE godb_pool_connections gauge")
+		fmt.Fprintf(cw, "godb_pool_connections %d\n", p.Connections)
+		fmt.Fprintln(cw, "# HELP godb_pool_active_streams Calls and streams in progress over the pool.")
+		fmt.Fprintln(cw, "# TYPE godb_pool_active_streams gauge")
+		fmt.Fprintf(cw, "godb_pool_active_streams %d\n", p.ActiveStreams)
+		fmt.Fprintln(cw, "# HELP godb_pool_max_streams_per_connection Cap on active streams per pooled connection.")
+		fmt.Fprintln(cw, "# TYPE godb_pool_max_streams_per_connection gauge")
+		fmt.Fprintf(cw, "godb_pool_max_streams_per_connection %d\n", p.MaxStreams)
+		fmt.Fprintln(cw, "# HELP godb_pool_utilization Share of the pool's stream capacity in use.")
+		fmt.Fprintln(cw, "# TYPE godb_pool_utilization gauge")
+		fmt.Fprintf(cw, "godb_pool_utilization %g\n", p.Utilization())
+	}
+	if cw.err != nil {
+		return cw.n, cw.err
+	}
+	return cw.n, cw.w.Flush()
+}
+
+// ServeHTTP serves the metrics to a Prometheus scraper.
+func (m *MetricsCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	m.WriteTo(w)
+}
+
+// countingWriter tracks the bytes written and the first error.
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+// Write implements io.Writer.
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	if cw.err != nil {
+		return 0, cw.err
+	}
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	cw.err = err
+	return n, err
+}
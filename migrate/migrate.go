@@ -0,0 +1,380 @@
+// Package migrate applies versioned schema migrations to a GoDB database.
+//
+// Migrations are registered with a version number and applied in ascending
+// order. Applied versions are recorded in the _godb_migrations table, and a
+// lock row in _godb_migrations_lock keeps concurrent runners from applying
+// the same migrations twice. The lock is a lease: the runner holding it renews
+// it while migrations run, and a runner that crashed loses it once the lease
+// expires.
+//
+//	m := migrate.New(client).
+//		Register(1, "create users", createUsers, dropUsers).
+//		RegisterScript(2, "add email", "ALTER TABLE users ADD COLUMN email TEXT",
+//			"ALTER TABLE users DROP COLUMN email")
+//	if err := m.Up(ctx); err != nil {
+//		log.Fatal(err)
+//	}
+package migrate
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+const (
+	// MigrationsTable records the applied migration versions.
+	MigrationsTable = "_godb_migrations"
+	// LockTable holds the lock row of the runner applying migrations.
+	LockTable = "_godb_migrations_lock"
+
+	lockID         = "migrate"
+	defaultLockTTL = time.Minute
+)
+
+// ErrLocked is returned when another runner holds the migration lock.
+var ErrLocked = errors.New("migrate: another runner holds the migration lock")
+
+// ErrLockLost is returned when the migration lock could not be renewed before
+// its lease expired, so another runner may have taken it. The migration
+// running at the time is interrupted through its context.
+var ErrLockLost = errors.New("migrate: migration lock lost")
+
+// Func applies or reverts one migration.
+type Func func(ctx context.Context, client *godb.GoDBClient) error
+
+// Migration is one versioned schema change.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      Func
+	Down    Func // nil if the migration cannot be reverted
+}
+
+// Migrator applies registered migrations to the client's database.
+type Migrator struct {
+	client     *godb.GoDBClient
+	migrations []Migration
+	lockTTL    time.Duration
+	err        error
+}
+
+// New returns a Migrator for the client's database.
+func New(client *godb.GoDBClient) *Migrator {
+	return &Migrator{client: client, lockTTL: defaultLockTTL}
+}
+
+// Register adds a migration implemented in Go. down may be nil.
+func (m *Migrator) Register(version int64, name string, up, down Func) *Migrator {
+	if up == nil {
+		m.setErr(fmt.Errorf("migration %d has no up function", version))
+		return m
+	}
+	for _, existing := range m.migrations {
+		if existing.Version == version {
+			m.setErr(fmt.Errorf("migration %d registered twice", version))
+			return m
+		}
+	}
+	m.migrations = append(m.migrations, Migration{Version: version, Name: name, Up: up, Down: down})
+	return m
+}
+
+// RegisterScript adds a migration written as a script of statements separated
+// by semicolons; see ParseScript for the supported statements. down may be
+// empty if the migration cannot be reverted.
+func (m *Migrator) RegisterScript(version int64, name, up, down string) *Migrator {
+	upFunc, err := ParseScript(up)
+	if err != nil {
+		m.setErr(fmt.Errorf("migration %d up script: %w", version, err))
+		return m
+	}
+	var downFunc Func
+	if down != "" {
+		if downFunc, err = ParseScript(down); err != nil {
+			m.setErr(fmt.Errorf("migration %d down script: %w", version, err))
+			return m
+		}
+	}
+	return m.Register(version, name, upFunc, downFunc)
+}
+
+// LockTTL sets the lease of the migration lock. The runner renews the lease
+// every third of d while migrations run; a runner that stops renewing it, e.g.
+// because it crashed, loses the lock once d has passed since the last renewal.
+// The default is one minute, which is also used when d is not positive.
+func (m *Migrator) LockTTL(d time.Duration) *Migrator {
+	if d <= 0 {
+		d = defaultLockTTL
+	}
+	m.lockTTL = d
+	return m
+}
+
+// setErr records the first registration error.
+func (m *Migrator) setErr(err error) {
+	if m.err == nil {
+		m.err = err
+	}
+}
+
+// Migrations returns the registered migrations in version order.
+func (m *Migrator) Migrations() []Migration {
+	sorted := append([]Migration(nil), m.migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+	return sorted
+}
+
+// Applied returns the versions already applied to the database, ascending.
+func (m *Migrator) Applied(ctx context.Context) ([]int64, error) {
+	if err := m.ensureTables(ctx); err != nil {
+		return nil, err
+	}
+	return m.applied(ctx)
+}
+
+// Up applies all pending migrations in version order, stopping at the first
+// failure. Migrations applied before the failure stay applied.
+func (m *Migrator) Up(ctx context.Context) (err error) {
+	if m.err != nil {
+		return m.err
+	}
+	if err := m.ensureTables(ctx); err != nil {
+		return err
+	}
+	ctx, release, err := m.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if rerr := release(); err == nil {
+			err = rerr
+		}
+	}()
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return err
+	}
+	done := make(map[int64]bool, len(applied))
+	for _, v := range applied {
+		done[v] = true
+	}
+	for _, mig := range m.Migrations() {
+		if done[mig.Version] {
+			continue
+		}
+		if err := mig.Up(ctx, m.client); err != nil {
+			return fmt.Errorf("migration %d (%s): %w", mig.Version, mig.Name, err)
+		}
+		if err := m.record(ctx, mig); err != nil {
+			return fmt.Errorf("migration %d (%s) applied but not recorded: %w", mig.Version, mig.Name, err)
+		}
+	}
+	return nil
+}
+
+// Down reverts the most recently applied migration.
+func (m *Migrator) Down(ctx context.Context) (err error) {
+	if m.err != nil {
+		return m.err
+	}
+	if err := m.ensureTables(ctx); err != nil {
+		return err
+	}
+	ctx, release, err := m.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if rerr := release(); err == nil {
+			err = rerr
+		}
+	}()
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return err
+	}
+	if len(applied) == 0 {
+		return nil
+	}
+	latest := applied[len(applied)-1]
+	var mig *Migration
+	for i := range m.migrations {
+		if m.migrations[i].Version == latest {
+			mig = &m.migrations[i]
+		}
+	}
+	if mig == nil {
+		return fmt.Errorf("applied migration %d is not registered", latest)
+	}
+	if mig.Down == nil {
+		return fmt.Errorf("migration %d (%s) cannot be reverted", mig.Version, mig.Name)
+	}
+	if err := mig.Down(ctx, m.client); err != nil {
+		return fmt.Errorf("reverting migration %d (%s): %w", mig.Version, mig.Name, err)
+	}
+	if _, err := m.client.Table(MigrationsTable).DeleteWhere(ctx, godb.Eq("version", mig.Version)); err != nil {
+		return fmt.Errorf("migration %d (%s) reverted but still recorded: %w", mig.Version, mig.Name, err)
+	}
+	return nil
+}
+
+// ensureTables creates the bookkeeping tables if they do not exist.
+func (m *Migrator) ensureTables(ctx context.Context) error {
+	tables := []struct {
+		name    string
+		columns map[string]string
+	}{
+		{MigrationsTable, map[string]string{"version": "INTEGER PRIMARY KEY", "name": "TEXT", "applied_at": "TEXT"}},
+		{LockTable, map[string]string{"id": "TEXT PRIMARY KEY", "owner": "TEXT", "acquired_at": "TEXT", "expires_at": "INTEGER"}},
+	}
+	for _, t := range tables {
+		desc, err := m.client.Table(t.name).Describe(ctx)
+		if err != nil {
+			return err
+		}
+		if !desc.Exists {
+			if _, err := m.client.Table(t.name).Create(ctx, t.columns); err != nil {
+				return err
+			}
+			continue
+		}
+		// Lock tables created before leases lack expires_at.
+		if t.name == LockTable && !hasColumn(desc.Columns, "expires_at") {
+			if _, err := m.client.Table(t.name).Alter(ctx).AddColumn("expires_at", "INTEGER").Exec(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hasColumn reports whether columns include name.
+func hasColumn(columns []*proto.ColumnInfo, name string) bool {
+	for _, col := range columns {
+		if col.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// applied lists the recorded versions, ascending.
+func (m *Migrator) applied(ctx context.Context) ([]int64, error) {
+	qb := m.client.Table(MigrationsTable).Find(ctx, nil).OrderBy("version ASC")
+	return godb.Select[int64](qb, "version")
+}
+
+// record marks mig as applied.
+func (m *Migrator) record(ctx context.Context, mig Migration) error {
+	_, err := m.client.Table(MigrationsTable).Insert(ctx, map[string]string{
+		"version":    strconv.FormatInt(mig.Version, 10),
+		"name":       mig.Name,
+		"applied_at": time.Now().UTC().Format(time.RFC3339),
+	})
+	return err
+}
+
+// lock takes the migration lock by inserting the lock row, which fails while
+// another runner's unexpired row exists, and renews its lease until released.
+// The returned context is cancelled with ErrLockLost if the lease cannot be
+// renewed in time; the returned function stops the renewal and deletes the
+// row, reporting ErrLockLost or a failure to delete it.
+func (m *Migrator) lock(ctx context.Context) (context.Context, func() error, error) {
+	table := m.client.Table(LockTable)
+	now := time.Now()
+	// A crashed runner's row stays behind until its lease is taken over.
+	if _, err := table.DeleteWhere(ctx, godb.And(godb.Eq("id", lockID), godb.Lt("expires_at", now.Unix()))); err != nil {
+		return nil, nil, fmt.Errorf("acquiring migration lock: %w", err)
+	}
+	owner := newOwnerID()
+	_, err := table.Insert(ctx, map[string]string{
+		"id":          lockID,
+		"owner":       owner,
+		"acquired_at": now.UTC().Format(time.RFC3339),
+		"expires_at":  strconv.FormatInt(now.Add(m.lockTTL).Unix(), 10),
+	})
+	if err != nil {
+		held, qerr := table.Find(ctx, godb.Eq("id", lockID)).Limit(1).Exec()
+		if qerr == nil && len(held.Rows) > 0 {
+			return nil, nil, fmt.Errorf("%w (owner %s since %s)", ErrLocked,
+				held.Rows[0].Data["owner"], held.Rows[0].Data["acquired_at"])
+		}
+		return nil, nil, fmt.Errorf("acquiring migration lock: %w", err)
+	}
+
+	lockCtx, cancel := context.WithCancelCause(ctx)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		m.renew(lockCtx, cancel, owner, now.Add(m.lockTTL), stop)
+	}()
+	release := func() error {
+		close(stop)
+		<-done
+		lost := context.Cause(lockCtx)
+		cancel(nil)
+		if errors.Is(lost, ErrLockLost) {
+			return lost
+		}
+		// Release even if ctx was cancelled mid-migration.
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if _, err := table.DeleteWhere(ctx, godb.And(godb.Eq("id", lockID), godb.Eq("owner", owner))); err != nil {
+			return fmt.Errorf("releasing migration lock: %w", err)
+		}
+		return nil
+	}
+	return lockCtx, release, nil
+}
+
+// renew extends the lease of owner's lock row every third of the lock TTL
+// until stop is closed. When the row is gone, or the lease expires before a
+// renewal succeeds, it cancels the migration with ErrLockLost.
+func (m *Migrator) renew(ctx context.Context, cancel context.CancelCauseFunc, owner string, expires time.Time, stop <-chan struct{}) {
+	ticker := time.NewTicker(m.lockTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		next := time.Now().Add(m.lockTTL)
+		res, err := m.client.UpdateRecord(ctx).
+			Table(LockTable).
+			Where(godb.And(godb.Eq("id", lockID), godb.Eq("owner", owner))).
+			SetUpdate("expires_at", next.Unix()).
+			ExecResult()
+		switch {
+		case err == nil && res.RowsAffected == 0:
+			cancel(fmt.Errorf("%w: the lock row was taken over", ErrLockLost))
+			return
+		case err == nil:
+			expires = next
+		case time.Now().After(expires):
+			cancel(fmt.Errorf("%w: renewing the lease: %v", ErrLockLost, err))
+			return
+		}
+	}
+}
+
+// newOwnerID returns a random identifier for this runner's lock.
+func newOwnerID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,193 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+)
+
+// ParseScript compiles a migration script into a Func. Statements are separated
+// by semicolons, keywords are case-insensitive, and each statement maps to one
+// SDK call:
+//
+//	CREATE TABLE t (col TYPE, ...)
+//	ALTER TABLE t ADD [COLUMN] col TYPE
+//	ALTER TABLE t DROP [COLUMN] col
+//	ALTER TABLE t RENAME [COLUMN] old TO new
+//	ALTER TABLE t ALTER [COLUMN] col TYPE newtype
+//	CREATE INDEX name ON t (col, ...)
+//	DROP INDEX name ON t
+//
+// Statements run in order; the script is not atomic across statements.
+func ParseScript(script string) (Func, error) {
+	var steps []Func
+	for _, stmt := range splitTopLevel(script, ';') {
+		stmt = strings.TrimSpace(stmt)
+		if stmt == "" {
+			continue
+		}
+		step, err := parseStatement(stmt)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", stmt, err)
+		}
+		steps = append(steps, step)
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("script has no statements")
+	}
+	return func(ctx context.Context, client *godb.GoDBClient) error {
+		for _, step := range steps {
+			if err := step(ctx, client); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+// parseStatement compiles a single statement.
+func parseStatement(stmt string) (Func, error) {
+	fields := strings.Fields(stmt)
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("unrecognized statement")
+	}
+	switch kw := strings.ToUpper(fields[0] + " " + fields[1]); kw {
+	case "CREATE TABLE":
+		return parseCreateTable(stmt)
+	case "ALTER TABLE":
+		return parseAlterTable(fields[2], fields[3:])
+	case "CREATE INDEX":
+		return parseCreateIndex(stmt)
+	case "DROP INDEX":
+		if len(fields) != 5 || !strings.EqualFold(fields[3], "ON") {
+			return nil, fmt.Errorf("expected DROP INDEX name ON table")
+		}
+		name, table := fields[2], fields[4]
+		return func(ctx context.Context, client *godb.GoDBClient) error {
+			_, err := client.Table(table).DropIndex(ctx, name)
+			return err
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported statement %s", kw)
+	}
+}
+
+// parseCreateTable compiles CREATE TABLE t (col TYPE, ...).
+func parseCreateTable(stmt string) (Func, error) {
+	head, body, err := splitParens(stmt)
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(head)
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("expected CREATE TABLE name (columns)")
+	}
+	table := fields[2]
+	columns := make(map[string]string)
+	for _, def := range splitTopLevel(body, ',') {
+		parts := strings.Fields(def)
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("column definition %q needs a name and type", strings.TrimSpace(def))
+		}
+		columns[parts[0]] = strings.Join(parts[1:], " ")
+	}
+	return func(ctx context.Context, client *godb.GoDBClient) error {
+		_, err := client.Table(table).Create(ctx, columns)
+		return err
+	}, nil
+}
+
+// parseAlterTable compiles the clause following ALTER TABLE t.
+func parseAlterTable(table string, clause []string) (Func, error) {
+	if len(clause) < 2 {
+		return nil, fmt.Errorf("incomplete ALTER TABLE")
+	}
+	action := strings.ToUpper(clause[0])
+	args := clause[1:]
+	if strings.EqualFold(args[0], "COLUMN") {
+		args = args[1:]
+	}
+	var alter func(*godb.UpdateTableBuilder)
+	switch {
+	case action == "ADD" && len(args) >= 2:
+		alter = func(b *godb.UpdateTableBuilder) { b.AddColumn(args[0], strings.Join(args[1:], " ")) }
+	case action == "DROP" && len(args) == 1:
+		alter = func(b *godb.UpdateTableBuilder) { b.DropColumn(args[0]) }
+	case action == "RENAME" && len(args) == 3 && strings.EqualFold(args[1], "TO"):
+		alter = func(b *godb.UpdateTableBuilder) { b.RenameColumn(args[0], args[2]) }
+	case action == "ALTER" && len(args) >= 3 && strings.EqualFold(args[1], "TYPE"):
+		alter = func(b *godb.UpdateTableBuilder) { b.ModifyColumn(args[0], strings.Join(args[2:], " ")) }
+	default:
+		return nil, fmt.Errorf("unsupported ALTER TABLE clause")
+	}
+	return func(ctx context.Context, client *godb.GoDBClient) error {
+		b := client.Table(table).Alter(ctx)
+		alter(b)
+		_, err := b.Exec()
+		return err
+	}, nil
+}
+
+// parseCreateIndex compiles CREATE INDEX name ON t (col, ...).
+func parseCreateIndex(stmt string) (Func, error) {
+	head, body, err := splitParens(stmt)
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(head)
+	if len(fields) != 5 || !strings.EqualFold(fields[3], "ON") {
+		return nil, fmt.Errorf("expected CREATE INDEX name ON table (columns)")
+	}
+	name, table := fields[2], fields[4]
+	var columns []string
+	for _, col := range strings.Split(body, ",") {
+		if col = strings.TrimSpace(col); col != "" {
+			columns = append(columns, col)
+		}
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("index needs at least one column")
+	}
+	return func(ctx context.Context, client *godb.GoDBClient) error {
+		_, err := client.Table(table).AddIndex(ctx, name, columns...)
+		return err
+	}, nil
+}
+
+// splitParens splits "head (body)" at its outermost parentheses.
+func splitParens(stmt string) (head, body string, err error) {
+	open := strings.Index(stmt, "(")
+	end := strings.LastIndex(stmt, ")")
+	if open < 0 || end < open || strings.TrimSpace(stmt[end+1:]) != "" {
+		return "", "", fmt.Errorf("expected a parenthesized list")
+	}
+	return stmt[:open], stmt[open+1 : end], nil
+}
+
+// splitTopLevel splits s on sep outside parentheses and quotes, so types such
+// as DECIMAL(10,2) stay whole.
+func splitTopLevel(s string, sep rune) []string {
+	var parts []string
+	depth, start := 0, 0
+	var quote rune
+	for i, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == sep && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + len(string(sep))
+		}
+	}
+	return append(parts, s[start:])
+}
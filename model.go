@@ -0,0 +1,173 @@
+package godb
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// modelField describes a struct field mapped to a table column through a `godb` tag.
+type modelField struct {
+	column    string
+	index     []int
+	omitEmpty bool
+	options   []string
+}
+
+// hasOption reports whether the field's tag carries the given option.
+func (f modelField) hasOption(opt string) bool {
+	for _, o := range f.options {
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
+
+// optionValue returns the value of a key=value tag option.
+func (f modelField) optionValue(key string) (string, bool) {
+	for _, o := range f.options {
+		if k, v, ok := strings.Cut(o, "="); ok && k == key {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// modelFields returns the column mapping for a struct type. Fields are named by
+// their `godb:"column"` tag, falling back to the snake_case field name; `godb:"-"`
+// skips a field, the omitempty option skips it when zero-valued, and the json
+// option stores it as JSON.
+func modelFields(t reflect.Type) []modelField {
+	var fields []modelField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("godb")
+		if tag == "-" {
+			continue
+		}
+		if sf.Anonymous && tag == "" && sf.Type.Kind() == reflect.Struct {
+			for _, f := range modelFields(sf.Type) {
+				f.index = append([]int{i}, f.index...)
+				fields = append(fields, f)
+			}
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		parts := splitTag(tag)
+		f := modelField{column: parts[0], index: []int{i}}
+		if f.column == "" {
+			f.column = snakeCase(sf.Name)
+		}
+		for _, opt := range parts[1:] {
+			opt = strings.TrimSpace(opt)
+			if opt == "omitempty" {
+				f.omitEmpty = true
+			}
+			f.options = append(f.options, opt)
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// splitTag splits a tag on commas that are not inside parentheses, so options
+// such as type=DECIMAL(10,2) stay intact.
+func splitTag(tag string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range tag {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, tag[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, tag[start:])
+}
+
+// structValue dereferences model and checks that it is a struct.
+func structValue(model interface{}) (reflect.Value, error) {
+	rv := reflect.ValueOf(model)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return reflect.Value{}, fmt.Errorf("model must not be nil")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("model must be a struct or pointer to struct, got %T", model)
+	}
+	return rv, nil
+}
+
+// encodeModel converts a tagged struct into a wire record and the typed values
+// of its columns, as encoded by codec. Nil pointers and zero-valued omitempty
+// fields are left out.
+func encodeModel(codec Codec, model interface{}) (map[string]string, map[string]*proto.Value, error) {
+	rv, err := structValue(model)
+	if err != nil {
+		return nil, nil, err
+	}
+	record := make(map[string]string)
+	typed := make(map[string]*proto.Value)
+	for _, f := range modelFields(rv.Type()) {
+		fv := rv.FieldByIndex(f.index)
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				continue
+			}
+			if _, ok := lookupCodec(fv.Type()); !ok {
+				fv = fv.Elem()
+			}
+		}
+		if f.omitEmpty && fv.IsZero() {
+			continue
+		}
+		if f.hasOption("json") {
+			encoded, err := marshalJSON(f.column, fv.Interface())
+			if err != nil {
+				return nil, nil, err
+			}
+			record[f.column] = encoded
+			continue
+		}
+		encoded, value, err := codec.EncodeColumn(fv.Interface())
+		if err != nil {
+			return nil, nil, fmt.Errorf("field %s: %w", f.column, err)
+		}
+		record[f.column] = encoded
+		if value != nil {
+			typed[f.column] = value
+		}
+	}
+	return record, typed, nil
+}
+
+// snakeCase converts a Go identifier such as CreatedAt into created_at.
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				sb.WriteByte('_')
+			}
+			sb.WriteRune(unicode.ToLower(r))
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
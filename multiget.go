@@ -0,0 +1,50 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// multiGetChunkSize caps the number of keys in one IN query.
+const multiGetChunkSize = 500
+
+// MultiGet fetches the rows of table whose keyColumn is one of values, using
+// one IN query per 500 keys instead of a read per key. The result maps each
+// found key, as returned by the server, to its row; missing keys are absent.
+func (c *GoDBClient) MultiGet(ctx context.Context, table, keyColumn string, values []interface{}) (map[string]map[string]string, error) {
+	return c.Table(table).multiGet(ctx, keyColumn, values)
+}
+
+// GetMany fetches the rows whose "id" is one of keys, keyed by id. See MultiGet.
+func (t *Table) GetMany(ctx context.Context, keys ...interface{}) (map[string]map[string]string, error) {
+	return t.multiGet(ctx, "id", keys)
+}
+
+// multiGet runs the chunked IN queries.
+func (t *Table) multiGet(ctx context.Context, keyColumn string, values []interface{}) (_ map[string]map[string]string, err error) {
+	defer wrapOpError(&err, "MultiGet", t.name, "", time.Now())
+	if keyColumn == "" {
+		return nil, fmt.Errorf("key column is required")
+	}
+	rows := make(map[string]map[string]string, len(values))
+	for start := 0; start < len(values); start += multiGetChunkSize {
+		end := start + multiGetChunkSize
+		if end > len(values) {
+			end = len(values)
+		}
+		//godbvet:ignore the IN list bounds the chunk
+		resp, err := t.Find(ctx, In(keyColumn, values[start:end]...)).Exec()
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range resp.Rows {
+			key, ok := row.Data[keyColumn]
+			if !ok {
+				return nil, fmt.Errorf("key column %s missing from result", keyColumn)
+			}
+			rows[key] = row.Data
+		}
+	}
+	return rows, nil
+}
@@ -0,0 +1,59 @@
+package godb
+
+import (
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// Null stands for SQL NULL. Use it as a value in Values maps, or pass it (or
+// nil) to Set and SetUpdate, to write NULL; compare with Eq or Ne to match it.
+// NULL columns are sent as typed values only, so servers that predate typed
+// values leave them unset.
+const Null = "\x00NULL\x00"
+
+// nullValue is the typed form of NULL.
+var nullValue = &proto.Value{Kind: &proto.Value_NullValue{NullValue: true}}
+
+// isNull reports whether value stands for NULL.
+func isNull(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	s, ok := value.(string)
+	return ok && s == Null
+}
+
+// extractNulls moves the Null columns of record into typed as null values. The
+// inputs are copied rather than changed when they hold any.
+func extractNulls(record map[string]string, typed map[string]*proto.Value) (map[string]string, map[string]*proto.Value) {
+	var nulls []string
+	for col, v := range record {
+		if v == Null {
+			nulls = append(nulls, col)
+		}
+	}
+	if len(nulls) == 0 {
+		return record, typed
+	}
+	record = copyRow(record, 0)
+	withNulls := make(map[string]*proto.Value, len(typed)+len(nulls))
+	for col, v := range typed {
+		withNulls[col] = v
+	}
+	for _, col := range nulls {
+		delete(record, col)
+		withNulls[col] = nullValue
+	}
+	return record, withNulls
+}
+
+// RowIsNull reports whether column is NULL in row: the server sent a typed
+// NULL or, as servers without typed values do, left the column out. An empty
+// string is not NULL.
+func RowIsNull(row *proto.QueryRow, column string) bool {
+	if v, ok := row.Values[column]; ok {
+		_, null := v.GetKind().(*proto.Value_NullValue)
+		return null
+	}
+	_, ok := row.Data[column]
+	return !ok
+}
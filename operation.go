@@ -0,0 +1,127 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// defaultPollInterval is how often Wait polls an operation's status.
+const defaultPollInterval = time.Second
+
+// Operation is a handle to a long-running server task such as an index build,
+// backfill, or restore.
+type Operation struct {
+	client       *GoDBClient
+	id           string
+	pollInterval time.Duration
+	onProgress   func(*proto.Operation)
+}
+
+// Operation returns a handle to the long-running operation with the given ID,
+// using the client's stored connection string.
+func (c *GoDBClient) Operation(id string) *Operation {
+	return &Operation{
+		client:       c,
+		id:           id,
+		pollInterval: defaultPollInterval,
+	}
+}
+
+// ID returns the operation ID.
+func (op *Operation) ID() string {
+	return op.id
+}
+
+// PollInterval sets how often Wait polls the operation's status. A d of zero
+// or less restores the default of one second.
+func (op *Operation) PollInterval(d time.Duration) *Operation {
+	if d <= 0 {
+		d = defaultPollInterval
+	}
+	op.pollInterval = d
+	return op
+}
+
+// OnProgress sets a callback invoked with each status observed by Wait.
+func (op *Operation) OnProgress(fn func(*proto.Operation)) *Operation {
+	op.onProgress = fn
+	return op
+}
+
+// Status fetches the current state and progress of the operation.
+func (op *Operation) Status(ctx context.Context) (_ *proto.Operation, err error) {
+	defer wrapOpError(&err, "GetOperation", "", proto.DatabaseService_GetOperation_FullMethodName, time.Now())
+	req := &proto.GetOperationRequest{
+		OperationId:      op.id,
+		ConnectionString: op.client.connectionStringFor(ctx),
+	}
+	return op.client.client.GetOperation(ctx, req)
+}
+
+// Cancel asks the server to stop the operation.
+func (op *Operation) Cancel(ctx context.Context) (_ string, err error) {
+	defer wrapOpError(&err, "CancelOperation", "", proto.DatabaseService_CancelOperation_FullMethodName, time.Now())
+	req := &proto.CancelOperationRequest{
+		OperationId:      op.id,
+		ConnectionString: op.client.connectionStringFor(ctx),
+	}
+	resp, err := op.client.client.CancelOperation(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Message, nil
+}
+
+// Wait polls the operation until it finishes or ctx is done. It returns the final
+// status, and an error if the operation failed or was cancelled.
+func (op *Operation) Wait(ctx context.Context) (_ *proto.Operation, err error) {
+	defer wrapOpError(&err, "WaitOperation", "", proto.DatabaseService_GetOperation_FullMethodName, time.Now())
+	ticker := time.NewTicker(op.pollInterval)
+	defer ticker.Stop()
+	for {
+		status, err := op.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if op.onProgress != nil {
+			op.onProgress(status)
+		}
+		switch status.State {
+		case proto.OperationState_OPERATION_STATE_SUCCEEDED:
+			return status, nil
+		case proto.OperationState_OPERATION_STATE_FAILED:
+			return status, fmt.Errorf("operation %s failed: %s", op.id, status.Error)
+		case proto.OperationState_OPERATION_STATE_CANCELLED:
+			return status, fmt.Errorf("operation %s was cancelled", op.id)
+		}
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// AddIndexAsync starts building an index in the background and returns a handle
+// to the resulting operation.
+func (c *GoDBClient) AddIndexAsync(ctx context.Context, tableName, indexName string, columns []string, connectionString string) (_ *Operation, err error) {
+	defer wrapOpError(&err, "AddIndex", tableName, proto.DatabaseService_AddIndex_FullMethodName, time.Now())
+	req := &proto.AddIndexRequest{
+		TableName:        tableName,
+		IndexName:        indexName,
+		Columns:          columns,
+		ConnectionString: connectionString,
+		Async:            true,
+	}
+	resp, err := c.client.AddIndex(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.OperationId == "" {
+		return nil, fmt.Errorf("server did not return an operation id")
+	}
+	return c.Operation(resp.OperationId), nil
+}
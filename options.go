@@ -0,0 +1,105 @@
+package godb
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Option configures a GoDBClient at construction time.
+type Option func(*clientOptions)
+
+// clientOptions holds the settings applied by Options.
+type clientOptions struct {
+	connectionString  string
+	dialOptions       []grpc.DialOption
+	unaryInterceptors []grpc.UnaryClientInterceptor
+	recoverPanics     bool
+	telemetry         *telemetry
+	defaultTimeout    time.Duration
+	versionCheck      *versionCheck
+	readVerify        *readVerifier
+	cacheTTL          time.Duration
+	cacheBackend      Cache
+	logger            Logger
+	history           *history
+	schemaPins        *schemaPins
+	retry             *retryPolicy
+	insertBatchSize   int
+	strictColumns     bool
+	journal           *journal
+	encodings         columnEncodings
+	columnMigrations  columnMigrations
+	virtualColumns    virtualColumns
+	dnsReresolve      *dnsReresolver
+	deadlineWarning   grpc.UnaryClientInterceptor
+	codec             Codec
+	tenantDatabase    func(string) string
+
+	maxReconnectAttempts int
+	maxConcurrentStreams int
+	compressionThreshold int
+}
+
+// WithDialOptions appends gRPC dial options used when connecting to the server.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *clientOptions) {
+		o.dialOptions = append(o.dialOptions, opts...)
+	}
+}
+
+// WithUnaryInterceptors adds interceptors to every unary call. They run inside
+// the SDK's own retries, caching, and logging, so they see each attempt with
+// the request as it is sent and the response as the server returned it.
+func WithUnaryInterceptors(interceptors ...grpc.UnaryClientInterceptor) Option {
+	return func(o *clientOptions) {
+		o.unaryInterceptors = append(o.unaryInterceptors, interceptors...)
+	}
+}
+
+// WithRecoverPanics converts panics raised inside user-supplied code run by the
+// SDK, such as registered codecs, into a *PanicError carrying the stack trace
+// instead of crashing the calling goroutine.
+func WithRecoverPanics() Option {
+	return func(o *clientOptions) {
+		o.recoverPanics = true
+	}
+}
+
+// WithCompression gzip-compresses requests. Responses are compressed when the
+// server supports it.
+func WithCompression() Option {
+	return WithDialOptions(grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+}
+
+// WithCompressionThreshold gzip-compresses only requests whose encoded size is
+// at least minBytes, so point reads skip the CPU cost while bulk writes still
+// shrink. Servers that mirror a request's compression compress the response
+// too, so large results of small queries stay uncompressed; use WithCompression
+// instead where those dominate.
+func WithCompressionThreshold(minBytes int) Option {
+	return func(o *clientOptions) {
+		o.compressionThreshold = minBytes
+	}
+}
+
+// compressionInterceptor compresses requests of at least minBytes.
+func compressionInterceptor(minBytes int) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if m, ok := req.(protobuf.Message); ok && protobuf.Size(m) >= minBytes {
+			opts = append(opts, grpc.UseCompressor(gzip.Name))
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// WithInsertBatchSize makes InsertMultiple send at most n records per request.
+// Batches are not atomic: if one fails, earlier batches stay inserted.
+func WithInsertBatchSize(n int) Option {
+	return func(o *clientOptions) {
+		o.insertBatchSize = n
+	}
+}
@@ -0,0 +1,118 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// Page is one page of a paginated query.
+type Page struct {
+	Rows       []map[string]string
+	Columns    []*proto.ColumnInfo
+	NextCursor string // opaque token; pass to ResumeFrom to continue
+	HasMore    bool
+
+	query    *QueryBuilder
+	pageSize int
+}
+
+// Paginate runs the query with keyset pagination and returns the first page of
+// at most pageSize rows. Rows are ordered by the query's ORDER BY column, or by
+// id without one, with id breaking ties; the ORDER BY may name one column and
+// a direction. The first page starts after the query's Cursor, CursorAfter, or
+// ResumeFrom position, if any. The query must not set a limit or offset.
+func (qb *QueryBuilder) Paginate(pageSize int) (*Page, error) {
+	if pageSize <= 0 {
+		return nil, fmt.Errorf("page size must be positive")
+	}
+	if qb.limit > 0 || qb.offset > 0 {
+		return nil, fmt.Errorf("paginated queries cannot set limit or offset")
+	}
+	if strings.Contains(qb.orderBy, ",") {
+		return nil, fmt.Errorf("paginated queries are ordered by a single column")
+	}
+	return qb.page(qb.ctx, qb.after, pageSize)
+}
+
+// page fetches the page after pos, asking for one extra row to learn whether
+// another page follows.
+func (qb *QueryBuilder) page(ctx context.Context, pos *cursorPos, pageSize int) (*Page, error) {
+	q := *qb
+	q.ctx = ctx
+	q.limit = pageSize + 1
+	if pos != qb.after {
+		q.cursor = ""
+		q.after, q.afterValue = pos, nil
+	}
+	column, desc := "id", false
+	if q.orderBy != "" {
+		column, desc = leadingOrder(q.orderBy)
+	} else if q.after != nil {
+		column, desc = q.after.Column, q.after.Desc
+	}
+	order := &cursorPos{Column: column, Desc: desc}
+	q.orderBy = order.order()
+	resp, err := q.Exec()
+	if err != nil {
+		return nil, err
+	}
+	p := &Page{Columns: resp.Columns, query: &q, pageSize: pageSize}
+	rows := resp.Rows
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		p.HasMore = true
+	}
+	p.Rows = make([]map[string]string, len(rows))
+	for i, row := range rows {
+		p.Rows[i] = row.Data
+	}
+	if len(p.Rows) > 0 {
+		last := p.Rows[len(p.Rows)-1]
+		next := &cursorPos{Column: column, Desc: desc}
+		var ok bool
+		if next.Value, ok = last[column]; !ok {
+			return nil, fmt.Errorf("paginated queries must select %s", column)
+		}
+		if column != "id" {
+			if next.ID, ok = last["id"]; !ok {
+				return nil, fmt.Errorf("paginated queries must select id")
+			}
+		}
+		p.NextCursor = next.token()
+	}
+	return p, nil
+}
+
+// Next fetches the following page, or returns nil when there is none.
+func (p *Page) Next(ctx context.Context) (*Page, error) {
+	if !p.HasMore {
+		return nil, nil
+	}
+	pos, err := parseCursorToken(p.NextCursor)
+	if err != nil {
+		return nil, err
+	}
+	return p.query.page(ctx, pos, p.pageSize)
+}
+
+// ForEachPage calls fn with this page and each following page in turn, stopping
+// at the last page or the first error from fn or a fetch.
+func (p *Page) ForEachPage(ctx context.Context, fn func(*Page) error) error {
+	for page := p; page != nil; {
+		if err := fn(page); err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		next, err := page.Next(ctx)
+		if err != nil {
+			return err
+		}
+		page = next
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package godb
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is returned in place of a panic recovered under WithRecoverPanics.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value interface{}
+	// Stack is the stack trace captured when the panic was recovered.
+	Stack []byte
+}
+
+// Error implements the error interface.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("recovered panic: %v\n%s", e.Value, e.Stack)
+}
+
+// recoverInto must be deferred directly. When the client recovers panics it turns
+// an in-flight panic into a *PanicError stored in *errp; otherwise the panic continues.
+func (c *GoDBClient) recoverInto(errp *error) {
+	if c == nil || !c.recoverPanics {
+		return
+	}
+	if r := recover(); r != nil {
+		*errp = &PanicError{Value: r, Stack: debug.Stack()}
+	}
+}
+
+// guarded calls fn, converting a panic into a *PanicError when the client recovers panics.
+func guarded[T any](c *GoDBClient, fn func() (T, error)) (result T, err error) {
+	defer c.recoverInto(&err)
+	return fn()
+}
@@ -0,0 +1,120 @@
+package godb
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Params are the values of named parameters, written :name in a condition.
+type Params map[string]interface{}
+
+// Named returns the condition text cond with each :name replaced by the
+// literal of params[name], encoded and escaped like the operands of Eq. A
+// parameter may appear any number of times; a slice value expands to a comma
+// separated list for use in IN (:ids). Text inside quotes and :: casts is left
+// alone.
+func Named(cond string, params Params) Cond {
+	return namedCond{text: cond, params: params}
+}
+
+// namedCond is condition text with named parameters.
+type namedCond struct {
+	text   string
+	params Params
+}
+
+func (c namedCond) render() (string, error) {
+	var b strings.Builder
+	src := c.text
+	var quote byte
+	for i := 0; i < len(src); i++ {
+		ch := src[i]
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"':
+			quote = ch
+		case ch == ':' && i+1 < len(src) && src[i+1] == ':':
+			b.WriteString("::")
+			i++
+			continue
+		case ch == ':' && i+1 < len(src) && isParamStart(src[i+1]):
+			j := i + 1
+			for j < len(src) && isParamChar(src[j]) {
+				j++
+			}
+			name := src[i+1 : j]
+			value, ok := c.params[name]
+			if !ok {
+				return "", fmt.Errorf("missing value for parameter :%s", name)
+			}
+			literal, err := paramLiteral(value)
+			if err != nil {
+				return "", fmt.Errorf("parameter :%s: %w", name, err)
+			}
+			b.WriteString(literal)
+			i = j - 1
+			continue
+		}
+		b.WriteByte(ch)
+	}
+	return b.String(), nil
+}
+
+// Literal renders value as it appears in condition text, encoded and escaped
+// like the operands of Eq: strings and times quoted, nil and Null as NULL, and
+// registered codec types encoded. It is for code that assembles conditions
+// itself, such as a SQL front end binding placeholders.
+func Literal(value interface{}) (string, error) {
+	return formatLiteral(value)
+}
+
+// paramLiteral renders a parameter value, expanding slices other than []byte
+// into a list.
+func paramLiteral(value interface{}) (string, error) {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice || rv.Type().Elem().Kind() == reflect.Uint8 {
+		return formatLiteral(value)
+	}
+	if _, ok := lookupCodec(rv.Type()); ok {
+		return formatLiteral(value)
+	}
+	if rv.Len() == 0 {
+		return "", fmt.Errorf("empty list")
+	}
+	parts := make([]string, rv.Len())
+	for i := range parts {
+		literal, err := formatLiteral(rv.Index(i).Interface())
+		if err != nil {
+			return "", err
+		}
+		parts[i] = literal
+	}
+	return strings.Join(parts, ", "), nil
+}
+
+func isParamStart(ch byte) bool {
+	return ch == '_' || ('a' <= ch && ch <= 'z') || ('A' <= ch && ch <= 'Z')
+}
+
+func isParamChar(ch byte) bool {
+	return isParamStart(ch) || ('0' <= ch && ch <= '9')
+}
+
+// conditionText returns cond as a condition, with named parameters when params
+// are given. Several Params are merged, later ones winning.
+func conditionText(cond string, params []Params) Cond {
+	if len(params) == 0 {
+		return rawCond(cond)
+	}
+	merged := make(Params)
+	for _, p := range params {
+		for name, v := range p {
+			merged[name] = v
+		}
+	}
+	return Named(cond, merged)
+}
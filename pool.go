@@ -0,0 +1,154 @@
+package godb
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// WithMaxConcurrentStreams caps the number of concurrent calls and streams on
+// one connection at n. When every connection is at the cap the client opens
+// another connection to the same address, so bulk work spreads over several
+// HTTP/2 connections instead of queuing behind one. Extra connections stay
+// open until Close.
+func WithMaxConcurrentStreams(n int) Option {
+	return func(o *clientOptions) {
+		o.maxConcurrentStreams = n
+	}
+}
+
+// connPool spreads calls over connections holding at most maxStreams each.
+type connPool struct {
+	mu         sync.Mutex
+	conns      []*pooledConn
+	maxStreams int
+	dial       func() (*grpc.ClientConn, error)
+	closed     bool
+}
+
+// pooledConn is a connection and its number of active calls.
+type pooledConn struct {
+	conn   *grpc.ClientConn
+	active int
+}
+
+// newConnPool returns a pool starting with primary; dial opens further
+// connections.
+func newConnPool(primary *grpc.ClientConn, maxStreams int, dial func() (*grpc.ClientConn, error)) *connPool {
+	return &connPool{
+		conns:      []*pooledConn{{conn: primary}},
+		maxStreams: maxStreams,
+		dial:       dial,
+	}
+}
+
+// acquire reserves a stream on the least busy connection with room, opening a
+// new connection when all are full.
+func (p *connPool) acquire() (*pooledConn, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var best *pooledConn
+	for _, pc := range p.conns {
+		if pc.active < p.maxStreams && (best == nil || pc.active < best.active) {
+			best = pc
+		}
+	}
+	if best == nil && !p.closed {
+		conn, err := p.dial()
+		if err != nil {
+			return nil, err
+		}
+		best = &pooledConn{conn: conn}
+		p.conns = append(p.conns, best)
+	}
+	if best == nil {
+		// Closed: let the primary connection report the error.
+		best = p.conns[0]
+	}
+	best.active++
+	return best, nil
+}
+
+// stats reports the pool's connections and the calls and streams active on
+// them.
+func (p *connPool) stats() PoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	s := PoolStats{Connections: len(p.conns), MaxStreams: p.maxStreams}
+	for _, pc := range p.conns {
+		s.ActiveStreams += pc.active
+	}
+	return s
+}
+
+// release frees a stream reserved by acquire.
+func (p *connPool) release(pc *pooledConn) {
+	p.mu.Lock()
+	pc.active--
+	p.mu.Unlock()
+}
+
+// Invoke implements grpc.ClientConnInterface.
+func (p *connPool) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	pc, err := p.acquire()
+	if err != nil {
+		return err
+	}
+	defer p.release(pc)
+	return pc.conn.Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream implements grpc.ClientConnInterface. The stream holds its slot
+// until it ends or ctx is done.
+func (p *connPool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	pc, err := p.acquire()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := pc.conn.NewStream(ctx, desc, method, opts...)
+	if err != nil {
+		cancel()
+		p.release(pc)
+		return nil, err
+	}
+	var once sync.Once
+	done := func() { once.Do(func() { p.release(pc) }) }
+	go func() {
+		<-ctx.Done()
+		done()
+	}()
+	return &pooledStream{ClientStream: stream, done: func() { done(); cancel() }}, nil
+}
+
+// close closes the connections opened by the pool; the primary is closed by
+// its owner.
+func (p *connPool) close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	var firstErr error
+	for _, pc := range p.conns[1:] {
+		if err := pc.conn.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	p.conns = p.conns[:1]
+	return firstErr
+}
+
+// pooledStream releases its pool slot once the stream ends.
+type pooledStream struct {
+	grpc.ClientStream
+	done func()
+}
+
+// RecvMsg implements grpc.ClientStream.
+func (s *pooledStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.done()
+	}
+	return err
+}
@@ -0,0 +1,127 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Stmt is a prepared query. The server parses and plans it once; each Exec only
+// binds values to its ? placeholders. A Stmt is safe for concurrent use.
+type Stmt struct {
+	client     *GoDBClient
+	query      *proto.QueryDataRequest
+	paramCount int
+
+	mu     sync.Mutex
+	id     string
+	closed bool
+}
+
+// Prepare prepares qb as a reusable statement. The query's conditions may
+// contain positional ? placeholders, e.g.
+//
+//	stmt, err := client.Prepare(ctx, client.Query(ctx).Table("users").Condition("id = ?"))
+//	resp, err := stmt.Exec(ctx, 42)
+func (c *GoDBClient) Prepare(ctx context.Context, qb *QueryBuilder) (_ *Stmt, err error) {
+	defer wrapOpError(&err, "Prepare", qb.tableName, proto.DatabaseService_Prepare_FullMethodName, time.Now())
+	query, err := qb.request()
+	if err != nil {
+		return nil, err
+	}
+	s := &Stmt{client: c, query: query}
+	if _, err := s.prepare(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// prepare (re)creates the statement on the server and returns its id. If the
+// Stmt was closed meanwhile, the new server statement is closed again.
+func (s *Stmt) prepare(ctx context.Context) (string, error) {
+	resp, err := s.client.client.Prepare(ctx, &proto.PrepareRequest{Query: s.query})
+	if err != nil {
+		return "", err
+	}
+	s.mu.Lock()
+	closed := s.closed
+	if !closed {
+		s.id = resp.StatementId
+		s.paramCount = int(resp.ParamCount)
+	}
+	s.mu.Unlock()
+	if closed {
+		s.client.client.ClosePrepared(ctx, &proto.ClosePreparedRequest{
+			StatementId:      resp.StatementId,
+			ConnectionString: s.query.ConnectionString,
+		})
+		return "", fmt.Errorf("statement is closed")
+	}
+	return resp.StatementId, nil
+}
+
+// Exec runs the statement with args bound to its placeholders in order. Values
+// are encoded like condition values. If the server has dropped the statement,
+// for instance after a restart, it is prepared again transparently.
+func (s *Stmt) Exec(ctx context.Context, args ...interface{}) (_ *proto.QueryDataResponse, err error) {
+	defer wrapOpError(&err, "ExecPrepared", s.query.TableName, proto.DatabaseService_ExecutePrepared_FullMethodName, time.Now())
+	params := make([]string, len(args))
+	for i, arg := range args {
+		literal, err := guarded(s.client, func() (string, error) {
+			return formatLiteral(arg)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("parameter %d: %w", i+1, err)
+		}
+		params[i] = literal
+	}
+	s.mu.Lock()
+	id, want, closed := s.id, s.paramCount, s.closed
+	s.mu.Unlock()
+	if closed {
+		return nil, fmt.Errorf("statement is closed")
+	}
+	if want > 0 && len(params) != want {
+		return nil, fmt.Errorf("statement takes %d parameters, got %d", want, len(params))
+	}
+	req := &proto.ExecutePreparedRequest{
+		StatementId:      id,
+		Params:           params,
+		ConnectionString: s.query.ConnectionString,
+		TableName:        s.query.TableName,
+	}
+	resp, err := s.client.client.ExecutePrepared(ctx, req)
+	if status.Code(err) == codes.NotFound {
+		if req.StatementId, err = s.prepare(ctx); err != nil {
+			return nil, err
+		}
+		resp, err = s.client.client.ExecutePrepared(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Close releases the statement on the server. Exec fails after Close.
+func (s *Stmt) Close(ctx context.Context) (err error) {
+	defer wrapOpError(&err, "ClosePrepared", s.query.TableName, proto.DatabaseService_ClosePrepared_FullMethodName, time.Now())
+	s.mu.Lock()
+	id, closed := s.id, s.closed
+	s.id, s.closed = "", true
+	s.mu.Unlock()
+	if closed {
+		return nil
+	}
+	_, err = s.client.client.ClosePrepared(ctx, &proto.ClosePreparedRequest{
+		StatementId:      id,
+		ConnectionString: s.query.ConnectionString,
+	})
+	return err
+}
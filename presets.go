@@ -0,0 +1,56 @@
+package godb
+
+import "time"
+
+// ProfileLowLatency tunes the client for small, latency-sensitive requests:
+// short deadlines, fast retries of reads, quick reconnects and a moderate
+// stream cap, so a burst of calls opens another connection instead of
+// queuing. Options passed after it override its settings.
+func ProfileLowLatency() Option {
+	return presetOption(
+		WithDefaultTimeout(2*time.Second),
+		WithRetry(2, 20*time.Millisecond),
+		WithReconnect(ReconnectPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 2 * time.Second}),
+		WithMaxConcurrentStreams(50),
+	)
+}
+
+// ProfileBulkIngest tunes the client for loading large volumes of data: long
+// deadlines, patient retries, gzip compression, a low stream cap that spreads
+// large requests over several connections, and insert batches of 1000.
+//
+// Because of the batch size, InsertMultiple is no longer atomic under this
+// profile: when a batch fails, the batches before it stay inserted. Pass
+// WithInsertBatchSize(0) after the profile, or call BatchSize(0) on the
+// builder, to send all records in one request.
+func ProfileBulkIngest() Option {
+	return presetOption(
+		WithDefaultTimeout(5*time.Minute),
+		WithRetry(5, 500*time.Millisecond),
+		WithCompression(),
+		WithInsertBatchSize(1000),
+		WithReconnect(ReconnectPolicy{BaseDelay: time.Second, MaxDelay: 30 * time.Second}),
+		WithMaxConcurrentStreams(20),
+	)
+}
+
+// ProfileInteractiveApp tunes the client for user-facing applications: deadlines
+// a person would wait for, a few quick retries, and a stream cap suited to
+// many concurrent users.
+func ProfileInteractiveApp() Option {
+	return presetOption(
+		WithDefaultTimeout(10*time.Second),
+		WithRetry(3, 100*time.Millisecond),
+		WithReconnect(ReconnectPolicy{BaseDelay: time.Second, MaxDelay: 10 * time.Second}),
+		WithMaxConcurrentStreams(100),
+	)
+}
+
+// presetOption combines opts into a single Option.
+func presetOption(opts ...Option) Option {
+	return func(o *clientOptions) {
+		for _, opt := range opts {
+			opt(o)
+		}
+	}
+}
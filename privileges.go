@@ -0,0 +1,70 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// Table-level privileges that can be granted to other users.
+const (
+	PrivilegeSelect = proto.Privilege_PRIVILEGE_SELECT
+	PrivilegeInsert = proto.Privilege_PRIVILEGE_INSERT
+	PrivilegeUpdate = proto.Privilege_PRIVILEGE_UPDATE
+	PrivilegeDelete = proto.Privilege_PRIVILEGE_DELETE
+)
+
+// GrantPrivileges gives username the privileges on table. The client's
+// connection string must belong to the table's owner.
+func (c *GoDBClient) GrantPrivileges(ctx context.Context, table, username string, privileges ...proto.Privilege) (_ string, err error) {
+	defer wrapOpError(&err, "GrantPrivileges", table, proto.DatabaseService_GrantPrivileges_FullMethodName, time.Now())
+	if len(privileges) == 0 {
+		return "", fmt.Errorf("at least one privilege is required")
+	}
+	req := &proto.GrantPrivilegesRequest{
+		ConnectionString: c.connectionStringFor(ctx),
+		TableName:        table,
+		Username:         username,
+		Privileges:       privileges,
+	}
+	resp, err := c.client.GrantPrivileges(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Message, nil
+}
+
+// RevokePrivileges takes the privileges on table away from username.
+func (c *GoDBClient) RevokePrivileges(ctx context.Context, table, username string, privileges ...proto.Privilege) (_ string, err error) {
+	defer wrapOpError(&err, "RevokePrivileges", table, proto.DatabaseService_RevokePrivileges_FullMethodName, time.Now())
+	if len(privileges) == 0 {
+		return "", fmt.Errorf("at least one privilege is required")
+	}
+	req := &proto.RevokePrivilegesRequest{
+		ConnectionString: c.connectionStringFor(ctx),
+		TableName:        table,
+		Username:         username,
+		Privileges:       privileges,
+	}
+	resp, err := c.client.RevokePrivileges(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Message, nil
+}
+
+// Permissions lists the users holding privileges on table and what they hold.
+func (c *GoDBClient) Permissions(ctx context.Context, table string) (_ []*proto.TablePermission, err error) {
+	defer wrapOpError(&err, "Permissions", table, proto.DatabaseService_ListPermissions_FullMethodName, time.Now())
+	req := &proto.ListPermissionsRequest{
+		ConnectionString: c.connectionStringFor(ctx),
+		TableName:        table,
+	}
+	resp, err := c.client.ListPermissions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Permissions, nil
+}
@@ -0,0 +1,132 @@
+package godb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// connectionProfileVersion is the schema version of exported profiles.
+const connectionProfileVersion = 1
+
+// defaultTokenRef is where profile consumers look up the credential by default.
+const defaultTokenRef = "env:GODB_TOKEN"
+
+// ConnectionProfile is a portable description of how to reach a GoDB database,
+// for sharing with other SDKs and tools. It never contains secrets; TokenRef names where the consumer should read the credential from,
+// such as "env:GODB_TOKEN" or "file:~/.godb/token".
+type ConnectionProfile struct {
+	Version  int    `json:"version"`
+	Address  string `json:"address"`
+	TLS      bool   `json:"tls"`
+	Database string `json:"database,omitempty"`
+	User     string `json:"user,omitempty"`
+	TokenRef string `json:"token_ref"`
+}
+
+// ConnectionProfile describes the connection of calls made with ctx, taking
+// the context's connection string and tenant into account. Its TokenRef is
+// "env:GODB_TOKEN"; change it before exporting if credentials live elsewhere.
+//
+// Dial options do not reveal whether they set up TLS, so TLS is learned from
+// the client's calls; a client that has not made one yet asks the server for
+// its capabilities first.
+func (c *GoDBClient) ConnectionProfile(ctx context.Context) *ConnectionProfile {
+	tls := false
+	if c.transport != nil { // nil for NewGoDBClientFromService
+		if c.transport.state.Load() == transportUnknown {
+			c.Capabilities(ctx)
+		}
+		tls = c.transport.state.Load() == transportTLS
+	}
+	user, database := connectionStringParts(c.connectionStringFor(ctx))
+	return &ConnectionProfile{
+		Version:  connectionProfileVersion,
+		Address:  c.address,
+		TLS:      tls,
+		Database: database,
+		User:     user,
+		TokenRef: defaultTokenRef,
+	}
+}
+
+// ExportConnectionProfile renders the connection profile of calls made with ctx
+// as "json" or "yaml".
+func (c *GoDBClient) ExportConnectionProfile(ctx context.Context, format string) ([]byte, error) {
+	return c.ConnectionProfile(ctx).Export(format)
+}
+
+// Export renders the profile as "json" or "yaml".
+func (p *ConnectionProfile) Export(format string) ([]byte, error) {
+	switch strings.ToLower(format) {
+	case "json":
+		return json.MarshalIndent(p, "", "  ")
+	case "yaml", "yml":
+		var b bytes.Buffer
+		fmt.Fprintf(&b, "version: %d\n", p.Version)
+		fmt.Fprintf(&b, "address: %s\n", strconv.Quote(p.Address))
+		fmt.Fprintf(&b, "tls: %t\n", p.TLS)
+		if p.Database != "" {
+			fmt.Fprintf(&b, "database: %s\n", strconv.Quote(p.Database))
+		}
+		if p.User != "" {
+			fmt.Fprintf(&b, "user: %s\n", strconv.Quote(p.User))
+		}
+		fmt.Fprintf(&b, "token_ref: %s\n", strconv.Quote(p.TokenRef))
+		return b.Bytes(), nil
+	}
+	return nil, fmt.Errorf("unsupported profile format %q", format)
+}
+
+// Transport states recorded by transportSecurity.
+const (
+	transportUnknown int32 = iota
+	transportPlain
+	transportTLS
+)
+
+// transportSecurity records whether the client's connection uses TLS, as seen
+// by the first call that reaches the server.
+type transportSecurity struct {
+	state atomic.Int32
+}
+
+func (t *transportSecurity) interceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if t.state.Load() != transportUnknown {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	var p peer.Peer
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Peer(&p))...)
+	if p.Addr != nil {
+		state := transportPlain
+		if p.AuthInfo != nil && p.AuthInfo.AuthType() == "tls" {
+			state = transportTLS
+		}
+		t.state.CompareAndSwap(transportUnknown, state)
+	}
+	return err
+}
+
+// connectionStringParts extracts the user and database from a connection string
+// of the form "scheme://user:password/database".
+func connectionStringParts(connStr string) (user, database string) {
+	rest := connStr
+	if i := strings.Index(rest, "://"); i >= 0 {
+		rest = rest[i+3:]
+	}
+	if i := strings.LastIndex(rest, "/"); i >= 0 {
+		database = rest[i+1:]
+		rest = rest[:i]
+	}
+	if i := strings.IndexAny(rest, ":@"); i >= 0 {
+		rest = rest[:i]
+	}
+	return rest, database
+}
@@ -0,0 +1,74 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// Message is a message received on a channel.
+type Message struct {
+	Channel     string
+	Payload     []byte
+	PublishedAt time.Time
+}
+
+// Publish sends payload to the current subscribers of channel in the client's
+// database. Delivery is at most once: subscribers that connect later, or are
+// reconnecting, miss the message.
+func (c *GoDBClient) Publish(ctx context.Context, channel string, payload []byte) (err error) {
+	defer wrapOpError(&err, "Publish", "", proto.DatabaseService_Publish_FullMethodName, time.Now())
+	if channel == "" {
+		return fmt.Errorf("channel is required")
+	}
+	_, err = c.client.Publish(ctx, &proto.PublishRequest{
+		ConnectionString: c.connectionStringFor(ctx),
+		Channel:          channel,
+		Payload:          payload,
+	})
+	return err
+}
+
+// Subscribe receives the messages published to channel in the client's
+// database from now until ctx is done.
+func (c *GoDBClient) Subscribe(ctx context.Context, channel string) (_ *Subscription, err error) {
+	defer wrapOpError(&err, "Subscribe", "", proto.DatabaseService_Subscribe_FullMethodName, time.Now())
+	if channel == "" {
+		return nil, fmt.Errorf("channel is required")
+	}
+	stream, err := c.client.Subscribe(ctx, &proto.SubscribeRequest{
+		ConnectionString: c.connectionStringFor(ctx),
+		Channel:          channel,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Subscription{ctx: ctx, stream: stream}, nil
+}
+
+// Subscription delivers the messages of a channel in the order they were
+// published.
+type Subscription struct {
+	ctx    context.Context
+	stream proto.DatabaseService_SubscribeClient
+}
+
+// Recv blocks until the next message. Once the subscription's context is done
+// it returns the context's error.
+func (s *Subscription) Recv() (_ Message, err error) {
+	defer wrapOpError(&err, "Subscribe", "", proto.DatabaseService_Subscribe_FullMethodName, time.Now())
+	msg, err := s.stream.Recv()
+	if err != nil {
+		if s.ctx.Err() != nil {
+			return Message{}, s.ctx.Err()
+		}
+		return Message{}, err
+	}
+	return Message{
+		Channel:     msg.Channel,
+		Payload:     msg.Payload,
+		PublishedAt: time.Unix(0, msg.PublishedUnixNanos),
+	}, nil
+}
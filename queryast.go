@@ -0,0 +1,63 @@
+package godb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/ast"
+)
+
+// Build returns the syntax tree of the query exactly as it would be sent,
+// including cursor conditions and the effective ORDER BY.
+func (qb *QueryBuilder) Build() (*ast.Query, error) {
+	req, err := qb.request()
+	if err != nil {
+		return nil, err
+	}
+	q, err := ast.Parse(req.Condition)
+	if err != nil {
+		return nil, err
+	}
+	q.Table = req.TableName
+	if req.Columns != "" {
+		for _, col := range strings.Split(req.Columns, ",") {
+			q.Columns = append(q.Columns, strings.TrimSpace(col))
+		}
+	}
+	return q, nil
+}
+
+// QueryFromAST returns a QueryBuilder for q, typically a tree returned by Build
+// or ast.Parse and then rewritten.
+func (client *GoDBClient) QueryFromAST(ctx context.Context, q *ast.Query) *QueryBuilder {
+	qb := client.Query(ctx).
+		Table(q.Table).
+		Columns(strings.Join(q.Columns, ", ")).
+		Where(FromAST(q.Where)).
+		GroupBy(q.GroupBy...).
+		Having(ast.Format(q.Having)).
+		Limit(q.Limit).
+		Offset(q.Offset)
+	terms := make([]string, len(q.OrderBy))
+	for i, t := range q.OrderBy {
+		terms[i] = t.Column
+		if t.Desc {
+			terms[i] += " DESC"
+		}
+	}
+	return qb.OrderBy(strings.Join(terms, ", "))
+}
+
+// FromAST converts an expression into a condition for a builder's Where method.
+func FromAST(e ast.Expr) Cond {
+	return astCond{e}
+}
+
+// astCond is a condition given as a syntax tree.
+type astCond struct {
+	expr ast.Expr
+}
+
+func (c astCond) render() (string, error) {
+	return ast.Format(c.expr), nil
+}
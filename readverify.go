@@ -0,0 +1,167 @@
+package godb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+)
+
+// Divergence describes a read whose result differed between the primary server
+// and the verification replica.
+type Divergence struct {
+	Table           string
+	Condition       string
+	PrimaryChecksum string
+	ReplicaChecksum string
+	PrimaryRows     int
+	ReplicaRows     int
+	// ReplicaErr is set when the replica could not answer the query.
+	ReplicaErr error
+}
+
+// WithReadVerify connects to a second replica at replicaAddress. Queries marked
+// with ReadVerify run against both servers, and onDivergence is called when the
+// result checksums differ or the replica fails. The primary's result is always
+// returned.
+func WithReadVerify(replicaAddress string, onDivergence func(*Divergence)) Option {
+	return func(o *clientOptions) {
+		o.readVerify = &readVerifier{address: replicaAddress, onDivergence: onDivergence}
+	}
+}
+
+// ReadVerify runs the query against both the primary and the replica configured
+// with WithReadVerify, comparing their results.
+func (qb *QueryBuilder) ReadVerify() *QueryBuilder {
+	qb.verify = true
+	return qb
+}
+
+// readVerifier holds the replica connection used to verify reads.
+type readVerifier struct {
+	address      string
+	onDivergence func(*Divergence)
+
+	conn   *grpc.ClientConn
+	client proto.DatabaseServiceClient
+}
+
+// dial connects to the replica.
+func (v *readVerifier) dial(opts []grpc.DialOption) error {
+	conn, err := grpc.NewClient(v.address, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to GoDB replica: %v", err)
+	}
+	v.conn = conn
+	v.client = proto.NewDatabaseServiceClient(conn)
+	return nil
+}
+
+// verifiedQuery sends req to the primary and the replica concurrently and reports
+// any divergence. Rows are compared in order only when the query is ordered.
+func (c *GoDBClient) verifiedQuery(ctx context.Context, req *proto.QueryDataRequest, ordered bool) (*proto.QueryDataResponse, error) {
+	if c.readVerify == nil {
+		return nil, fmt.Errorf("read verify requires the WithReadVerify option")
+	}
+	var (
+		wg         sync.WaitGroup
+		replica    *proto.QueryDataResponse
+		replicaErr error
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		replica, replicaErr = c.readVerify.client.QueryData(ctx, req)
+	}()
+	primary, err := c.client.QueryData(ctx, req)
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	d := &Divergence{
+		Table:           req.TableName,
+		Condition:       req.Condition,
+		PrimaryChecksum: rowsChecksum(primary.Rows, ordered),
+		PrimaryRows:     len(primary.Rows),
+		ReplicaErr:      replicaErr,
+	}
+	if replicaErr == nil {
+		d.ReplicaChecksum = rowsChecksum(replica.Rows, ordered)
+		d.ReplicaRows = len(replica.Rows)
+	}
+	if d.ReplicaErr != nil || d.PrimaryChecksum != d.ReplicaChecksum {
+		if c.readVerify.onDivergence != nil {
+			c.readVerify.onDivergence(d)
+		}
+	}
+	return primary, nil
+}
+
+// rowsChecksum returns a SHA-256 checksum of rows, covering both their string
+// data and their typed values. Unless ordered is set, rows are sorted first so
+// that the server's row order does not matter.
+func rowsChecksum(rows []*proto.QueryRow, ordered bool) string {
+	encoded := make([]string, len(rows))
+	for i, row := range rows {
+		keys := make([]string, 0, len(row.Data))
+		for k := range row.Data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var b strings.Builder
+		for _, k := range keys {
+			fmt.Fprintf(&b, "%q=%q;", k, row.Data[k])
+		}
+		keys = keys[:0]
+		for k := range row.Values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			kind, value := checksumValue(row.Values[k])
+			fmt.Fprintf(&b, "%q:%s=%q;", k, kind, value)
+		}
+		encoded[i] = b.String()
+	}
+	if !ordered {
+		sort.Strings(encoded)
+	}
+	h := sha256.New()
+	for _, e := range encoded {
+		h.Write([]byte(e))
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// checksumValue returns the kind of a typed value, named as its field in
+// Value, and its string form for rowsChecksum. Timestamps are given in
+// microseconds and NULL as an empty string.
+func checksumValue(v *proto.Value) (kind, value string) {
+	s, _ := valueString(v)
+	switch k := v.GetKind().(type) {
+	case *proto.Value_StringValue:
+		return "string_value", s
+	case *proto.Value_IntValue:
+		return "int_value", s
+	case *proto.Value_DoubleValue:
+		return "double_value", s
+	case *proto.Value_BoolValue:
+		return "bool_value", s
+	case *proto.Value_BytesValue:
+		return "bytes_value", s
+	case *proto.Value_TimestampMicros:
+		return "timestamp_micros", strconv.FormatInt(k.TimestampMicros, 10)
+	case *proto.Value_NullValue:
+		return "null_value", ""
+	}
+	return "", ""
+}
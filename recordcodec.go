@@ -0,0 +1,47 @@
+package godb
+
+import "github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+// Codec encodes the column values of records written by the builders. Each
+// column is sent as a wire string and, optionally, a typed value; a codec
+// decides both, so a transport or server expecting another encoding needs a
+// new Codec rather than changes to the builders. NULLs never reach a codec.
+//
+// Only writes go through a Codec: rows read back and condition values are
+// handled as the server and the condition helpers define them. A Codec must
+// therefore send wire strings that the server stores as the value itself.
+type Codec interface {
+	// Name identifies the encoding, e.g. "proto".
+	Name() string
+	// EncodeColumn returns the wire string of value and its typed form, or nil
+	// to send the string alone.
+	EncodeColumn(value interface{}) (string, *proto.Value, error)
+}
+
+// WithCodec encodes record values with c instead of ProtoCodec.
+func WithCodec(c Codec) Option {
+	return func(o *clientOptions) {
+		o.codec = c
+	}
+}
+
+// ProtoCodec is the default Codec. Numbers, booleans, byte slices, and times
+// are sent as typed values besides their string form; strings and registered
+// types as strings only.
+type ProtoCodec struct{}
+
+// Name implements Codec.
+func (ProtoCodec) Name() string { return "proto" }
+
+// EncodeColumn implements Codec.
+func (ProtoCodec) EncodeColumn(value interface{}) (string, *proto.Value, error) {
+	return encodeColumn(value)
+}
+
+// recordCodec returns the client's Codec.
+func (c *GoDBClient) recordCodec() Codec {
+	if c == nil || c.codec == nil {
+		return ProtoCodec{}
+	}
+	return c.codec
+}
@@ -0,0 +1,216 @@
+package godb
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RedisCache is a Cache backed by a Redis server, letting several instances
+// share cached results. It speaks the Redis protocol over a single connection,
+// which is re-established after an error.
+type RedisCache struct {
+	// Addr is the Redis server address, e.g. "localhost:6379".
+	Addr string
+	// Password, when set, is sent with AUTH after connecting.
+	Password string
+	// DB selects the Redis database.
+	DB int
+	// Prefix is prepended to every key.
+	Prefix string
+	// Timeout bounds each command; zero means 5 seconds.
+	Timeout time.Duration
+
+	mu   sync.Mutex
+	conn net.Conn
+	rd   *bufio.Reader
+}
+
+// NewRedisCache returns a RedisCache for the server at addr.
+func NewRedisCache(addr string) *RedisCache {
+	return &RedisCache{Addr: addr}
+}
+
+// Get implements Cache.
+func (r *RedisCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := r.do(ctx, "GET", r.Prefix+key)
+	if err != nil {
+		return nil, false, err
+	}
+	if reply == nil {
+		return nil, false, nil
+	}
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("redis: unexpected GET reply %v", reply)
+	}
+	return value, true, nil
+}
+
+// Set implements Cache.
+func (r *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	args := []string{"SET", r.Prefix + key, string(value)}
+	if ttl > 0 {
+		ms := ttl.Milliseconds()
+		if ms < 1 {
+			ms = 1
+		}
+		args = append(args, "PX", strconv.FormatInt(ms, 10))
+	}
+	_, err := r.do(ctx, args...)
+	return err
+}
+
+// Delete implements Cache.
+func (r *RedisCache) Delete(ctx context.Context, key string) error {
+	_, err := r.do(ctx, "DEL", r.Prefix+key)
+	return err
+}
+
+// Close closes the connection to Redis.
+func (r *RedisCache) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.conn == nil {
+		return nil
+	}
+	err := r.conn.Close()
+	r.conn = nil
+	return err
+}
+
+// do sends a command and reads its reply, dropping the connection on failure.
+func (r *RedisCache) do(ctx context.Context, args ...string) (interface{}, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.conn == nil {
+		if err := r.connect(ctx); err != nil {
+			return nil, err
+		}
+	}
+	reply, err := r.roundTrip(ctx, args)
+	if err != nil {
+		if _, ok := err.(redisError); !ok {
+			r.conn.Close()
+			r.conn = nil
+		}
+		return nil, err
+	}
+	return reply, nil
+}
+
+// connect dials Redis and authenticates.
+func (r *RedisCache) connect(ctx context.Context) error {
+	d := net.Dialer{Timeout: r.timeout()}
+	conn, err := d.DialContext(ctx, "tcp", r.Addr)
+	if err != nil {
+		return fmt.Errorf("redis: %v", err)
+	}
+	r.conn = conn
+	r.rd = bufio.NewReader(conn)
+	if r.Password != "" {
+		if _, err := r.roundTrip(ctx, []string{"AUTH", r.Password}); err != nil {
+			r.conn.Close()
+			r.conn = nil
+			return err
+		}
+	}
+	if r.DB != 0 {
+		if _, err := r.roundTrip(ctx, []string{"SELECT", strconv.Itoa(r.DB)}); err != nil {
+			r.conn.Close()
+			r.conn = nil
+			return err
+		}
+	}
+	return nil
+}
+
+// timeout returns the per-command timeout.
+func (r *RedisCache) timeout() time.Duration {
+	if r.Timeout > 0 {
+		return r.Timeout
+	}
+	return 5 * time.Second
+}
+
+// roundTrip writes a command as a RESP array and reads one reply.
+func (r *RedisCache) roundTrip(ctx context.Context, args []string) (interface{}, error) {
+	deadline := time.Now().Add(r.timeout())
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	r.conn.SetDeadline(deadline)
+	buf := []byte("*" + strconv.Itoa(len(args)) + "\r\n")
+	for _, a := range args {
+		buf = append(buf, "$"+strconv.Itoa(len(a))+"\r\n"...)
+		buf = append(buf, a...)
+		buf = append(buf, "\r\n"...)
+	}
+	if _, err := r.conn.Write(buf); err != nil {
+		return nil, fmt.Errorf("redis: %v", err)
+	}
+	return readRESP(r.rd)
+}
+
+// redisError is an error reply from the server.
+type redisError string
+
+// Error implements the error interface.
+func (e redisError) Error() string {
+	return "redis: " + string(e)
+}
+
+// readRESP reads one RESP reply: a string, error, integer, bulk string ([]byte,
+// or nil when absent), or array.
+func readRESP(rd *bufio.Reader) (interface{}, error) {
+	line, err := rd.ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("redis: %v", err)
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("redis: malformed reply %q", line)
+	}
+	kind, body := line[0], line[1:len(line)-2]
+	switch kind {
+	case '+':
+		return body, nil
+	case '-':
+		return nil, redisError(body)
+	case ':':
+		return strconv.ParseInt(body, 10, 64)
+	case '$':
+		n, err := strconv.Atoi(body)
+		if err != nil {
+			return nil, fmt.Errorf("redis: malformed reply %q", line)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		data := make([]byte, n+2)
+		if _, err := io.ReadFull(rd, data); err != nil {
+			return nil, fmt.Errorf("redis: %v", err)
+		}
+		return data[:n], nil
+	case '*':
+		n, err := strconv.Atoi(body)
+		if err != nil {
+			return nil, fmt.Errorf("redis: malformed reply %q", line)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		items := make([]interface{}, n)
+		for i := range items {
+			if items[i], err = readRESP(rd); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("redis: unknown reply type %q", kind)
+}
@@ -0,0 +1,87 @@
+package godb
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// Result reports the effect of a write. Servers that predate these fields
+// report no rows affected and no ids.
+type Result struct {
+	RowsAffected int64
+	InsertedIDs  []string
+	Message      string
+	// Returned holds the columns named by the builder's Returning, one row per
+	// affected row.
+	Returned []*proto.QueryRow
+}
+
+// Scan decodes the returned rows into dst, which is a pointer to a slice of
+// structs or maps for every row, or a pointer to a struct or map for the first
+// one. Scanning a single row from an empty result returns ErrNoRows.
+func (r Result) Scan(dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("destination must be a non-nil pointer, got %T", dst)
+	}
+	rv = rv.Elem()
+	if rv.Kind() != reflect.Slice {
+		if len(r.Returned) == 0 {
+			return ErrNoRows
+		}
+		return decodeRow(r.Returned[0], rv)
+	}
+	out := reflect.MakeSlice(rv.Type(), len(r.Returned), len(r.Returned))
+	for i, row := range r.Returned {
+		if err := decodeRow(row, out.Index(i)); err != nil {
+			return fmt.Errorf("row %d: %w", i, err)
+		}
+	}
+	rv.Set(out)
+	return nil
+}
+
+// insertedIDs wraps a single inserted id, which is empty when none was reported.
+func insertedIDs(id string) []string {
+	if id == "" {
+		return nil
+	}
+	return []string{id}
+}
+
+// changeImages returns the before and after images an UpdateRecordResponse
+// carries for ExecChanges.
+func changeImages(reply interface{}) []*proto.QueryRow {
+	resp, ok := reply.(*proto.UpdateRecordResponse)
+	if !ok {
+		return nil
+	}
+	var rows []*proto.QueryRow
+	for _, change := range resp.Changes {
+		for _, row := range []*proto.QueryRow{change.Before, change.After} {
+			if row != nil {
+				rows = append(rows, row)
+			}
+		}
+	}
+	return rows
+}
+
+// returnedRows returns the rows a write response carries for Returning.
+func returnedRows(reply interface{}) []*proto.QueryRow {
+	switch r := reply.(type) {
+	case *proto.InsertRecordResponse:
+		return r.Returned
+	case *proto.InsertMultipleRecordsResponse:
+		return r.Returned
+	case *proto.UpsertRecordResponse:
+		return r.Returned
+	case *proto.UpdateRecordResponse:
+		return r.Returned
+	case *proto.DeleteRecordResponse:
+		return r.Returned
+	}
+	return nil
+}
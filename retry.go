@@ -0,0 +1,110 @@
+package godb
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// idempotentMethods are the RPCs safe to retry without side effects.
+var idempotentMethods = map[string]bool{
+	proto.DatabaseService_Capabilities_FullMethodName:    true,
+	proto.DatabaseService_QueryData_FullMethodName:       true,
+	proto.DatabaseService_DescribeTable_FullMethodName:   true,
+	proto.DatabaseService_ListIndexes_FullMethodName:     true,
+	proto.DatabaseService_ListForeignKeys_FullMethodName: true,
+	proto.DatabaseService_Explain_FullMethodName:         true,
+	proto.DatabaseService_ExecutePrepared_FullMethodName: true,
+	proto.DatabaseService_IndexStats_FullMethodName:      true,
+	proto.DatabaseService_ListDatabases_FullMethodName:   true,
+	proto.DatabaseService_ListTables_FullMethodName:      true,
+	proto.DatabaseService_ListUsers_FullMethodName:       true,
+	proto.DatabaseService_ListPermissions_FullMethodName: true,
+	proto.DatabaseService_GetOperation_FullMethodName:    true,
+}
+
+// WithRetry retries read-only calls that fail with Unavailable or
+// ResourceExhausted up to maxAttempts times in total, waiting baseDelay before
+// the first retry and doubling it, with jitter, for each one after. Writes are
+// never retried since the server may have applied them.
+//
+// When the call has a deadline, each attempt gets an equal share of the time
+// left for the attempts still to come, so a slow first attempt cannot use up
+// the whole deadline; an attempt that runs out of its share is retried like an
+// unavailable server. The last attempt gets all the time left.
+func WithRetry(maxAttempts int, baseDelay time.Duration) Option {
+	return func(o *clientOptions) {
+		o.retry = &retryPolicy{maxAttempts: maxAttempts, baseDelay: baseDelay}
+	}
+}
+
+// retryPolicy retries transient failures of idempotent calls.
+type retryPolicy struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	onRetry     func(method string)
+}
+
+// retryable reports whether err is worth retrying.
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// noRetryKey marks a context whose calls are retried by their caller, such as
+// ReadCritical, so the retry policy does not multiply its attempts.
+type noRetryKey struct{}
+
+// interceptor retries idempotent calls.
+func (p *retryPolicy) interceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if !idempotentMethods[method] || ctx.Value(noRetryKey{}) != nil {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	err := p.attempt(ctx, p.maxAttempts, method, req, reply, cc, invoker, opts)
+	delay := p.baseDelay
+	for attempt := 1; attempt < p.maxAttempts && p.retryable(ctx, err); attempt++ {
+		wait := delay
+		if delay > 0 {
+			wait = delay/2 + time.Duration(rand.Int63n(int64(delay)))
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+		if p.onRetry != nil {
+			p.onRetry(method)
+		}
+		err = p.attempt(ctx, p.maxAttempts-attempt, method, req, reply, cc, invoker, opts)
+		delay *= 2
+	}
+	return err
+}
+
+// attempt makes one call with its share of the deadline, out of left attempts.
+func (p *retryPolicy) attempt(ctx context.Context, left int, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts []grpc.CallOption) error {
+	if deadline, ok := ctx.Deadline(); ok && left > 1 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Until(deadline)/time.Duration(left))
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// retryable reports whether err is worth retrying, including an attempt that
+// ran out of its share of a deadline ctx still has time left on.
+func (p *retryPolicy) retryable(ctx context.Context, err error) bool {
+	if status.Code(err) == codes.DeadlineExceeded && ctx.Err() == nil {
+		return true
+	}
+	return retryable(err)
+}
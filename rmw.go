@@ -0,0 +1,123 @@
+package godb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+// ErrConflict is returned by ReadModifyWrite when the row kept changing
+// between its read and its update on every attempt.
+var ErrConflict = errors.New("row changed concurrently")
+
+// RMWOption configures ReadModifyWrite.
+type RMWOption func(*rmwConfig)
+
+type rmwConfig struct {
+	versionColumn string
+	maxAttempts   int
+	backoff       time.Duration
+}
+
+// WithVersionColumn names the integer column ReadModifyWrite checks and
+// increments on each update. The default is "version".
+func WithVersionColumn(column string) RMWOption {
+	return func(c *rmwConfig) { c.versionColumn = column }
+}
+
+// WithRMWAttempts sets how many times ReadModifyWrite reads and updates the row
+// before giving up with ErrConflict, waiting around backoff, doubled each
+// time, between attempts. The default is 5 attempts from 10ms.
+func WithRMWAttempts(maxAttempts int, backoff time.Duration) RMWOption {
+	return func(c *rmwConfig) {
+		c.maxAttempts = maxAttempts
+		c.backoff = backoff
+	}
+}
+
+// ReadModifyWrite reads the single row of table matching cond, passes its
+// values to fn, and writes the updates fn returns only if no one else updated
+// the row in between, using the version column for optimistic locking. On a
+// conflict it reads the row again and calls fn again, so fn must not have
+// side effects beyond computing the updates. fn returning no updates leaves
+// the row alone; an error from fn is returned as is.
+//
+// The row must have the version column, and the server must report rows
+// affected by updates. A missing row returns ErrNoRows and several matching
+// rows return ErrMultipleRows.
+func (c *GoDBClient) ReadModifyWrite(ctx context.Context, table string, cond Cond, fn func(row map[string]string) (map[string]interface{}, error), opts ...RMWOption) (err error) {
+	defer wrapOpError(&err, "ReadModifyWrite", table, "", time.Now())
+	defer c.recoverInto(&err)
+	cfg := rmwConfig{versionColumn: "version", maxAttempts: 5, backoff: 10 * time.Millisecond}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cond == nil {
+		return fmt.Errorf("condition is required")
+	}
+	delay := cfg.backoff
+	for attempt := 1; ; attempt++ {
+		done, err := c.readModifyWriteOnce(ctx, table, cond, fn, cfg.versionColumn)
+		if err != nil || done {
+			return err
+		}
+		if attempt >= cfg.maxAttempts {
+			return ErrConflict
+		}
+		wait := delay
+		if delay > 0 {
+			wait = delay/2 + time.Duration(rand.Int63n(int64(delay)))
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		delay *= 2
+	}
+}
+
+// readModifyWriteOnce makes one attempt, reporting false when the row's
+// version changed before the update.
+func (c *GoDBClient) readModifyWriteOnce(ctx context.Context, table string, cond Cond, fn func(map[string]string) (map[string]interface{}, error), versionColumn string) (bool, error) {
+	resp, err := c.Query(ctx).Table(table).Where(cond).Limit(2).Exec()
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case len(resp.Rows) == 0:
+		return false, ErrNoRows
+	case len(resp.Rows) > 1:
+		return false, ErrMultipleRows
+	}
+	row := resp.Rows[0].Data
+	raw, ok := row[versionColumn]
+	if !ok {
+		return false, fmt.Errorf("row has no %s column", versionColumn)
+	}
+	version, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("column %s: %w", versionColumn, err)
+	}
+	updates, err := fn(copyRow(row, 0))
+	if err != nil || len(updates) == 0 {
+		return err == nil, err
+	}
+	if _, ok := updates[versionColumn]; ok {
+		return false, fmt.Errorf("updates must not set the version column %s", versionColumn)
+	}
+	res, err := c.UpdateRecord(ctx).
+		Table(table).
+		Where(cond).
+		Where(Eq(versionColumn, version)).
+		Updates(updates).
+		SetUpdate(versionColumn, version+1).
+		ExecResult()
+	if err != nil {
+		return false, err
+	}
+	return res.RowsAffected > 0, nil
+}
@@ -0,0 +1,116 @@
+package godb
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// Select runs the query restricted to columns and decodes the results into a []T.
+// With a single column each row's value is decoded directly into T, e.g.
+// Select[string](qb, "email") or Select[int64](qb, "id"). With several columns
+// T must be a struct whose godb tags name the selected columns. qb itself is
+// left unchanged.
+func Select[T any](qb *QueryBuilder, columns ...string) (_ []T, err error) {
+	defer wrapOpError(&err, "Select", qb.tableName, "", time.Now())
+	defer qb.client.recoverInto(&err)
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("at least one column is required")
+	}
+	q := *qb
+	resp, err := q.Columns(strings.Join(columns, ", ")).Exec()
+	if err != nil {
+		return nil, err
+	}
+	nullable := make(map[string]bool, len(resp.Columns))
+	for _, col := range resp.Columns {
+		nullable[col.Name] = col.Nullable
+	}
+	out := make([]T, len(resp.Rows))
+	for i, row := range resp.Rows {
+		dst := reflect.ValueOf(&out[i]).Elem()
+		if len(columns) == 1 && dst.Kind() != reflect.Struct {
+			var ok bool
+			ok, err = decodeColumn(row, columns[0], dst)
+			if !ok && !nullable[columns[0]] {
+				return nil, fmt.Errorf("column %s missing from row %d", columns[0], i)
+			}
+		} else {
+			err = decodeRow(row, dst)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", i, err)
+		}
+	}
+	return out, nil
+}
+
+// ColumnNames returns the result's column names in select order. Responses from
+// servers that do not describe their columns fall back to the sorted column
+// names of the first row.
+func ColumnNames(resp *proto.QueryDataResponse) []string {
+	if len(resp.Columns) > 0 {
+		names := make([]string, len(resp.Columns))
+		for i, col := range resp.Columns {
+			names[i] = col.Name
+		}
+		return names
+	}
+	if len(resp.Rows) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(resp.Rows[0].Data))
+	for name := range resp.Rows[0].Data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// decodeRow fills a struct or map[string]string from a result row. Struct fields are
+// matched to columns with the same rules as Insert's Model and decoded from
+// typed values where the server sent them; columns without a matching field
+// are ignored.
+func decodeRow(row *proto.QueryRow, dst reflect.Value) error {
+	for dst.Kind() == reflect.Ptr {
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		dst = dst.Elem()
+	}
+	switch dst.Kind() {
+	case reflect.Struct:
+		for _, f := range modelFields(dst.Type()) {
+			if f.hasOption("json") {
+				if raw, ok := row.Data[f.column]; ok {
+					if err := json.Unmarshal([]byte(raw), dst.FieldByIndex(f.index).Addr().Interface()); err != nil {
+						return fmt.Errorf("column %s: %w", f.column, err)
+					}
+				}
+				continue
+			}
+			if _, err := decodeColumn(row, f.column, dst.FieldByIndex(f.index)); err != nil {
+				return fmt.Errorf("column %s: %w", f.column, err)
+			}
+		}
+		return nil
+	case reflect.Map:
+		if dst.Type().Key().Kind() != reflect.String || dst.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported scan destination %s", dst.Type())
+		}
+		if dst.IsNil() {
+			dst.Set(reflect.MakeMapWithSize(dst.Type(), len(row.Data)))
+		}
+		for k, v := range row.Data {
+			dst.SetMapIndex(reflect.ValueOf(k), reflect.ValueOf(v))
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan destination %s", dst.Type())
+	}
+}
@@ -0,0 +1,161 @@
+package godb
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// errNoActivation reports a schedule that found no activation time within
+// five years.
+var errNoActivation = errors.New("schedule has no activation time within five years")
+
+// ScheduleOption configures a scheduled query.
+type ScheduleOption func(*ScheduledQuery)
+
+// WithJitter delays each run by a random duration of up to d, spreading load
+// when many instances share a schedule.
+func WithJitter(d time.Duration) ScheduleOption {
+	return func(s *ScheduledQuery) {
+		s.jitter = d
+	}
+}
+
+// ScheduleStats reports the activity of a scheduled query.
+type ScheduleStats struct {
+	Runs         int64
+	Failures     int64
+	Skipped      int64 // runs skipped because the previous run was still going
+	LastRun      time.Time
+	LastDuration time.Duration
+	LastError    error
+	NextRun      time.Time
+}
+
+// ScheduledQuery is a query run periodically by Schedule.
+type ScheduledQuery struct {
+	schedule *cronSchedule
+	query    *QueryBuilder
+	handler  func(*proto.QueryDataResponse, error)
+	jitter   time.Duration
+
+	mu      sync.Mutex
+	running bool
+	stats   ScheduleStats
+
+	stop chan struct{}
+	once sync.Once
+	wg   sync.WaitGroup
+}
+
+// Schedule runs qb on the cron schedule spec, passing each result to handler.
+// spec is a five-field cron expression, an @hourly style shorthand, or
+// "@every <duration>". A run is skipped while the previous one is still in
+// progress. Scheduling stops when Stop is called or the query's context is done.
+// If the schedule stops because it finds no further activation time, Stats
+// reports that in LastError.
+func (c *GoDBClient) Schedule(spec string, qb *QueryBuilder, handler func(*proto.QueryDataResponse, error), opts ...ScheduleOption) (*ScheduledQuery, error) {
+	schedule, err := parseCron(spec)
+	if err != nil {
+		return nil, err
+	}
+	if schedule.next(time.Now()).IsZero() {
+		return nil, errNoActivation
+	}
+	s := &ScheduledQuery{
+		schedule: schedule,
+		query:    qb,
+		handler:  handler,
+		stop:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.wg.Add(1)
+	go s.loop()
+	return s, nil
+}
+
+// Stop stops the schedule and waits for a run in progress to finish.
+func (s *ScheduledQuery) Stop() {
+	s.once.Do(func() { close(s.stop) })
+	s.wg.Wait()
+}
+
+// Stats returns a snapshot of the schedule's activity.
+func (s *ScheduledQuery) Stats() ScheduleStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stats
+}
+
+// loop waits for each activation and starts a run.
+func (s *ScheduledQuery) loop() {
+	defer s.wg.Done()
+	done := s.query.ctx.Done()
+	for {
+		next := s.schedule.next(time.Now())
+		if next.IsZero() {
+			s.mu.Lock()
+			s.stats.LastError = errNoActivation
+			s.stats.NextRun = time.Time{}
+			s.mu.Unlock()
+			return
+		}
+		if s.jitter > 0 {
+			next = next.Add(time.Duration(rand.Int63n(int64(s.jitter))))
+		}
+		s.mu.Lock()
+		s.stats.NextRun = next
+		s.mu.Unlock()
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-s.stop:
+			timer.Stop()
+			return
+		case <-done:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		s.mu.Lock()
+		if s.running {
+			s.stats.Skipped++
+			s.mu.Unlock()
+			continue
+		}
+		s.running = true
+		s.mu.Unlock()
+		s.wg.Add(1)
+		go s.run()
+	}
+}
+
+// run executes the query once and records the outcome.
+func (s *ScheduledQuery) run() {
+	defer s.wg.Done()
+	start := time.Now()
+	resp, err := s.query.Exec()
+	if s.handler != nil {
+		_, herr := guarded(s.query.client, func() (struct{}, error) {
+			s.handler(resp, err)
+			return struct{}{}, nil
+		})
+		if err == nil {
+			err = herr
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.running = false
+	s.stats.Runs++
+	if err != nil {
+		s.stats.Failures++
+	}
+	s.stats.LastRun = start
+	s.stats.LastDuration = time.Since(start)
+	s.stats.LastError = err
+}
@@ -0,0 +1,93 @@
+package godb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// QueryResult is the payload delivered to a ResultSink.
+type QueryResult struct {
+	Table      string              `json:"table"`
+	Columns    []*proto.ColumnInfo `json:"columns,omitempty"`
+	Rows       []map[string]string `json:"rows"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+	QueriedAt  time.Time           `json:"queried_at"`
+}
+
+// ResultSink receives query results, e.g. to feed them to an external system.
+type ResultSink interface {
+	Send(ctx context.Context, result *QueryResult) error
+}
+
+// SendTo executes the query and delivers its result to sink.
+func (qb *QueryBuilder) SendTo(sink ResultSink) (err error) {
+	defer wrapOpError(&err, "SendTo", qb.tableName, "", time.Now())
+	resp, err := qb.Exec()
+	if err != nil {
+		return err
+	}
+	result := &QueryResult{
+		Table:      qb.tableName,
+		Columns:    resp.Columns,
+		Rows:       make([]map[string]string, len(resp.Rows)),
+		NextCursor: resp.NextCursor,
+		QueriedAt:  time.Now().UTC(),
+	}
+	for i, row := range resp.Rows {
+		result.Rows[i] = row.Data
+	}
+	if err := sink.Send(qb.ctx, result); err != nil {
+		return fmt.Errorf("failed to send results: %w", err)
+	}
+	return nil
+}
+
+// WebhookSink posts each result as JSON to a URL.
+type WebhookSink struct {
+	URL     string
+	Headers map[string]string
+	Client  *http.Client
+}
+
+// NewWebhookSink returns a WebhookSink posting to url with a 30 second timeout.
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{
+		URL:    url,
+		Client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Send posts result to the webhook. Any non-2xx response is an error.
+func (s *WebhookSink) Send(ctx context.Context, result *QueryResult) error {
+	body, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.Headers {
+		req.Header.Set(k, v)
+	}
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
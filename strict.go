@@ -0,0 +1,85 @@
+package godb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+)
+
+// ErrUnknownColumn is matched by errors.Is when WithStrictColumns rejects a
+// write naming a column the table does not have.
+var ErrUnknownColumn = errors.New("unknown column")
+
+// WithStrictColumns checks the columns of every insert, upsert, and update
+// against the table's schema before sending it, so a misspelled column fails at
+// the call site instead of being ignored or rejected late by the server. The
+// schema is fetched with DescribeTable; combine with WithCache to avoid a
+// lookup per write.
+func WithStrictColumns() Option {
+	return func(o *clientOptions) {
+		o.strictColumns = true
+	}
+}
+
+// strictColumnsInterceptor rejects writes naming unknown columns.
+func strictColumnsInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var table, connStr string
+	var columns []string
+	switch r := req.(type) {
+	case *proto.InsertRecordRequest:
+		table, connStr = r.TableName, r.ConnectionString
+		columns = appendColumnNames(appendColumnNames(columns, r.Record), r.TypedRecord)
+	case *proto.InsertMultipleRecordsRequest:
+		table, connStr = r.TableName, r.ConnectionString
+		for _, rec := range r.Records {
+			columns = appendColumnNames(appendColumnNames(columns, rec.Data), rec.TypedData)
+		}
+	case *proto.UpsertRecordRequest:
+		table, connStr = r.TableName, r.ConnectionString
+		columns = appendColumnNames(appendColumnNames(columns, r.Record), r.TypedRecord)
+	case *proto.UpdateRecordRequest:
+		table, connStr = r.TableName, r.ConnectionString
+		columns = appendColumnNames(appendColumnNames(columns, r.Updates), r.TypedUpdates)
+	default:
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	// Going through cc runs the full chain, so the describe can be cached.
+	desc := &proto.DescribeTableResponse{}
+	describe := &proto.DescribeTableRequest{TableName: table, ConnectionString: connStr}
+	if err := cc.Invoke(ctx, proto.DatabaseService_DescribeTable_FullMethodName, describe, desc, opts...); err != nil {
+		return fmt.Errorf("checking columns: %w", err)
+	}
+	if desc.Exists {
+		known := make(map[string]bool, len(desc.Columns))
+		for _, col := range desc.Columns {
+			known[col.Name] = true
+		}
+		var unknown []string
+		for _, col := range columns {
+			if !known[col] {
+				unknown = append(unknown, col)
+				known[col] = true // report each name once
+			}
+		}
+		if len(unknown) > 0 {
+			sort.Strings(unknown)
+			return fmt.Errorf("%w %s in table %s", ErrUnknownColumn, strings.Join(unknown, ", "), table)
+		}
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// appendColumnNames appends the keys of a string or typed record to names.
+func appendColumnNames[V string | *proto.Value](names []string, record map[string]V) []string {
+	for col := range record {
+		names = append(names, col)
+	}
+	return names
+}
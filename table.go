@@ -0,0 +1,133 @@
+package godb
+
+import (
+	"context"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// Table is a handle to one table, so call sites need not repeat its name.
+type Table struct {
+	client           *GoDBClient
+	name             string
+	connectionString string // empty for the client's tables
+}
+
+// Table returns a handle to the named table using the client's connection
+// string, or the one each call's context carries.
+func (c *GoDBClient) Table(name string) *Table {
+	return &Table{client: c, name: name}
+}
+
+// Table returns a handle to the named table in the database.
+func (db *Database) Table(name string) *Table {
+	return &Table{client: db.client, name: name, connectionString: db.connectionString}
+}
+
+// connString returns the connection string of a call made with ctx: the
+// database's for a Database table, else the client's for ctx.
+func (t *Table) connString(ctx context.Context) string {
+	if t.connectionString != "" {
+		return t.connectionString
+	}
+	return t.client.connectionStringFor(ctx)
+}
+
+// Name returns the table name.
+func (t *Table) Name() string {
+	return t.name
+}
+
+// Create creates the table with the given column definitions.
+func (t *Table) Create(ctx context.Context, columns map[string]string) (string, error) {
+	return t.client.CreateTable(ctx, t.name, columns, t.connString(ctx))
+}
+
+// Alter returns an UpdateTableBuilder for changing the table's columns.
+func (t *Table) Alter(ctx context.Context) *UpdateTableBuilder {
+	utb := t.client.UpdateTable(ctx).Table(t.name)
+	utb.connectionString = t.connString(ctx)
+	return utb
+}
+
+// Insert inserts rec, which is either a map[string]string of column values or a
+// struct whose fields carry `godb:"column"` tags.
+func (t *Table) Insert(ctx context.Context, rec interface{}) (string, error) {
+	ib := t.client.Insert(ctx).Table(t.name)
+	ib.connectionString = t.connString(ctx)
+	if values, ok := rec.(map[string]string); ok {
+		ib.Values(values)
+	} else {
+		ib.Model(rec)
+	}
+	return ib.Exec()
+}
+
+// Find returns a QueryBuilder for rows matching cond, or all rows when cond is
+// nil. Add columns, ordering, or limits before calling Exec.
+func (t *Table) Find(ctx context.Context, cond Cond) *QueryBuilder {
+	qb := t.client.Query(ctx).Table(t.name)
+	qb.connectionString = t.connString(ctx)
+	if cond != nil {
+		qb.Where(cond)
+	}
+	return qb
+}
+
+// UpdateWhere sets updates on the rows matching cond.
+func (t *Table) UpdateWhere(ctx context.Context, cond Cond, updates map[string]interface{}) (string, error) {
+	urb := t.client.UpdateRecord(ctx).Table(t.name)
+	urb.connectionString = t.connString(ctx)
+	return urb.Where(cond).Updates(updates).Exec()
+}
+
+// DeleteWhere deletes the rows matching cond.
+func (t *Table) DeleteWhere(ctx context.Context, cond Cond) (string, error) {
+	db := t.client.Delete(ctx).Table(t.name)
+	db.connectionString = t.connString(ctx)
+	return db.Where(cond).Exec()
+}
+
+// Describe reports whether the table exists and lists its columns.
+func (t *Table) Describe(ctx context.Context) (*proto.DescribeTableResponse, error) {
+	return t.client.DescribeTable(ctx, t.name, t.connString(ctx))
+}
+
+// AddIndex creates an index on the given columns.
+func (t *Table) AddIndex(ctx context.Context, indexName string, columns ...string) (string, error) {
+	return t.client.AddIndex(ctx, t.name, indexName, columns, t.connString(ctx))
+}
+
+// AddUniqueIndex creates an index on the given columns that rejects rows
+// duplicating another row's values in them.
+func (t *Table) AddUniqueIndex(ctx context.Context, indexName string, columns ...string) (_ string, err error) {
+	defer wrapOpError(&err, "AddIndex", t.name, proto.DatabaseService_AddIndex_FullMethodName, time.Now())
+	return t.client.addIndex(ctx, &proto.AddIndexRequest{
+		TableName:        t.name,
+		IndexName:        indexName,
+		Columns:          columns,
+		Unique:           true,
+		ConnectionString: t.connString(ctx),
+	})
+}
+
+// DropIndex deletes the named index.
+func (t *Table) DropIndex(ctx context.Context, indexName string) (string, error) {
+	return t.client.DeleteIndex(ctx, indexName, t.connString(ctx))
+}
+
+// Indexes lists the indexes defined on the table.
+func (t *Table) Indexes(ctx context.Context) ([]*proto.Index, error) {
+	resp, err := t.client.ListIndexes(ctx, t.connString(ctx))
+	if err != nil {
+		return nil, err
+	}
+	var indexes []*proto.Index
+	for _, idx := range resp.Indexes {
+		if idx.TableName == t.name {
+			indexes = append(indexes, idx)
+		}
+	}
+	return indexes, nil
+}
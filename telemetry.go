@@ -0,0 +1,130 @@
+package godb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"runtime"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// defaultTelemetryInterval is how often usage stats are reported.
+const defaultTelemetryInterval = time.Hour
+
+// TelemetryReport is the anonymous payload posted to the telemetry endpoint.
+// It never contains connection strings, table names, or record data.
+type TelemetryReport struct {
+	SDKVersion string           `json:"sdk_version"`
+	GoVersion  string           `json:"go_version"`
+	OS         string           `json:"os"`
+	Arch       string           `json:"arch"`
+	Features   map[string]int64 `json:"features"`
+	ErrorCodes map[string]int64 `json:"error_codes"`
+}
+
+// WithTelemetry opts in to anonymous usage reporting. Every interval the client
+// posts a TelemetryReport as JSON to endpoint, counting calls per RPC and errors per
+// gRPC status code. Telemetry is off unless this option is given.
+func WithTelemetry(endpoint string, interval time.Duration) Option {
+	return func(o *clientOptions) {
+		if interval <= 0 {
+			interval = defaultTelemetryInterval
+		}
+		o.telemetry = &telemetry{
+			endpoint:   endpoint,
+			interval:   interval,
+			httpClient: &http.Client{Timeout: 10 * time.Second},
+			features:   make(map[string]int64),
+			errorCodes: make(map[string]int64),
+			done:       make(chan struct{}),
+		}
+	}
+}
+
+// telemetry accumulates usage counters and reports them periodically.
+type telemetry struct {
+	endpoint   string
+	interval   time.Duration
+	httpClient *http.Client
+
+	mu         sync.Mutex
+	features   map[string]int64
+	errorCodes map[string]int64
+
+	done     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// interceptor counts each RPC and its resulting status code.
+func (t *telemetry) interceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	t.mu.Lock()
+	t.features[method]++
+	if err != nil {
+		t.errorCodes[status.Code(err).String()]++
+	}
+	t.mu.Unlock()
+	return err
+}
+
+// start launches the background reporter.
+func (t *telemetry) start() {
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.flush()
+			case <-t.done:
+				t.flush()
+				return
+			}
+		}
+	}()
+}
+
+// stop sends a final report and stops the reporter. Later calls only wait.
+func (t *telemetry) stop() {
+	t.stopOnce.Do(func() { close(t.done) })
+	t.wg.Wait()
+}
+
+// flush posts the counters collected since the last report. Reporting is best
+// effort: failures are dropped silently so telemetry never affects the application.
+func (t *telemetry) flush() {
+	t.mu.Lock()
+	if len(t.features) == 0 {
+		t.mu.Unlock()
+		return
+	}
+	report := TelemetryReport{
+		SDKVersion: Version,
+		GoVersion:  runtime.Version(),
+		OS:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		Features:   t.features,
+		ErrorCodes: t.errorCodes,
+	}
+	t.features = make(map[string]int64)
+	t.errorCodes = make(map[string]int64)
+	t.mu.Unlock()
+
+	body, err := json.Marshal(report)
+	if err != nil {
+		return
+	}
+	resp, err := t.httpClient.Post(t.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+	resp.Body.Close()
+}
@@ -0,0 +1,98 @@
+package godb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// connectionContextKey and tenantContextKey are the context keys of a
+// request's connection string and tenant.
+type (
+	connectionContextKey struct{}
+	tenantContextKey     struct{}
+)
+
+// WithConnection returns a context whose calls use connStr instead of the
+// client's connection string. Builders, Table handles, and client methods
+// given the context use it, so one client can serve requests for different
+// users concurrently. Database handles and methods that take a connection
+// string keep using theirs.
+func WithConnection(ctx context.Context, connStr string) context.Context {
+	return context.WithValue(ctx, connectionContextKey{}, connStr)
+}
+
+// ConnectionFromContext returns the connection string carried by ctx, if any.
+func ConnectionFromContext(ctx context.Context) (string, bool) {
+	connStr, ok := ctx.Value(connectionContextKey{}).(string)
+	return connStr, ok
+}
+
+// tenantID matches valid tenant ids.
+var tenantID = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// WithTenant returns a context whose calls use the tenant's database, with
+// the credentials of the context's or client's connection string. The
+// database is named by the tenant id unless the client was built with
+// WithTenantDatabase. The id must be non-empty and contain only letters,
+// digits, and underscores.
+//
+// The tenant selects whose data a call reads, so take it from the
+// authenticated identity, never from a value the caller controls such as a
+// request header:
+//
+//	func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//		user, err := s.auth.Authenticate(r)
+//		if err != nil {
+//			http.Error(w, "unauthorized", http.StatusUnauthorized)
+//			return
+//		}
+//		ctx, err := godb.WithTenant(r.Context(), user.TenantID)
+//		if err != nil {
+//			http.Error(w, err.Error(), http.StatusForbidden)
+//			return
+//		}
+//		orders, err := s.client.Query(ctx).Table("orders").Exec()
+//		...
+//	}
+func WithTenant(ctx context.Context, id string) (context.Context, error) {
+	if id == "" {
+		return nil, errors.New("tenant id is empty")
+	}
+	if !tenantID.MatchString(id) {
+		return nil, fmt.Errorf("invalid tenant id %q: use only letters, digits, and underscores", id)
+	}
+	return context.WithValue(ctx, tenantContextKey{}, id), nil
+}
+
+// TenantFromContext returns the tenant carried by ctx, if any.
+func TenantFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(tenantContextKey{}).(string)
+	return id, ok
+}
+
+// WithTenantDatabase sets how WithTenant maps a tenant id to a database name,
+// for example func(id string) string { return "tenant_" + id }.
+func WithTenantDatabase(fn func(id string) string) Option {
+	return func(o *clientOptions) {
+		o.tenantDatabase = fn
+	}
+}
+
+// connectionStringFor returns the connection string of calls made with ctx:
+// the context's, else the client's, with the database replaced by the
+// context's tenant.
+func (c *GoDBClient) connectionStringFor(ctx context.Context) string {
+	connStr := c.connectionString
+	if s, ok := ConnectionFromContext(ctx); ok {
+		connStr = s
+	}
+	if id, ok := TenantFromContext(ctx); ok {
+		if c.tenantDatabase != nil {
+			id = c.tenantDatabase(id)
+		}
+		connStr = withDatabaseName(connStr, id)
+	}
+	return connStr
+}
@@ -0,0 +1,187 @@
+// Package testsnapshot compares the contents of GoDB tables with golden files,
+// for regression tests of code that writes data.
+//
+// A test runs the code under test, then matches the tables it writes:
+//
+//	testsnapshot.New(client).
+//		Table("orders").
+//		Table("order_items").
+//		Ignore("created_at").
+//		Match(ctx, t, "checkout")
+//
+// The first run, and any run with GODB_UPDATE_SNAPSHOTS=1 in the environment,
+// writes testdata/checkout.golden. Later runs fail with a line diff when the
+// tables no longer match it. Rows are written one per line as JSON with sorted
+// keys, and sorted, so the server's row order does not matter.
+package testsnapshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	godb "github.com/prakhar-5447/GoDB_SDK_GO"
+)
+
+// UpdateEnv is the environment variable that rewrites golden files when set to 1.
+const UpdateEnv = "GODB_UPDATE_SNAPSHOTS"
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 2
+
+// Snapshot selects the tables to dump and compare.
+type Snapshot struct {
+	client *godb.GoDBClient
+	tables []string
+	ignore map[string]bool
+}
+
+// New returns a Snapshot of no tables.
+func New(client *godb.GoDBClient) *Snapshot {
+	return &Snapshot{client: client, ignore: make(map[string]bool)}
+}
+
+// Table adds a table to the snapshot. Tables are dumped in the order added.
+func (s *Snapshot) Table(name string) *Snapshot {
+	s.tables = append(s.tables, name)
+	return s
+}
+
+// Ignore leaves columns out of every table, e.g. timestamps and generated ids
+// that differ between runs. A column may be given as table.column to leave it
+// out of one table only.
+func (s *Snapshot) Ignore(columns ...string) *Snapshot {
+	for _, col := range columns {
+		s.ignore[col] = true
+	}
+	return s
+}
+
+// Dump returns the snapshot's text: a header line per table followed by its
+// rows.
+func (s *Snapshot) Dump(ctx context.Context) (string, error) {
+	var b strings.Builder
+	for _, table := range s.tables {
+		//godbvet:ignore a snapshot holds the whole table
+		resp, err := s.client.Query(ctx).Table(table).Exec()
+		if err != nil {
+			return "", fmt.Errorf("dump %s: %w", table, err)
+		}
+		lines := make([]string, 0, len(resp.Rows))
+		for _, row := range resp.Rows {
+			data := make(map[string]string, len(row.Data))
+			for col, v := range row.Data {
+				if !s.ignore[col] && !s.ignore[table+"."+col] {
+					data[col] = v
+				}
+			}
+			line, err := json.Marshal(data)
+			if err != nil {
+				return "", err
+			}
+			lines = append(lines, string(line))
+		}
+		sort.Strings(lines)
+		fmt.Fprintf(&b, "== %s (%d rows)\n", table, len(lines))
+		for _, line := range lines {
+			b.WriteString(line)
+			b.WriteByte('\n')
+		}
+	}
+	return b.String(), nil
+}
+
+// Match compares the snapshot with testdata/<name>.golden, writing the file
+// instead when it does not exist yet or GODB_UPDATE_SNAPSHOTS=1 is set.
+func (s *Snapshot) Match(ctx context.Context, t testing.TB, name string) {
+	t.Helper()
+	got, err := s.Dump(ctx)
+	if err != nil {
+		t.Fatalf("testsnapshot: %v", err)
+	}
+	path := filepath.Join("testdata", name+".golden")
+	want, err := os.ReadFile(path)
+	if os.Getenv(UpdateEnv) == "1" || os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("testsnapshot: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatalf("testsnapshot: %v", err)
+		}
+		t.Logf("testsnapshot: wrote %s", path)
+		return
+	}
+	if err != nil {
+		t.Fatalf("testsnapshot: %v", err)
+	}
+	if string(want) != got {
+		t.Errorf("testsnapshot: tables differ from %s (-want +got); rerun with %s=1 to accept:\n%s",
+			path, UpdateEnv, Diff(string(want), got))
+	}
+}
+
+// Diff returns a line diff of want and got, with unchanged lines near each
+// change prefixed by a space, removed lines by "-", and added lines by "+".
+func Diff(want, got string) string {
+	a := strings.Split(strings.TrimSuffix(want, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	var lines []string
+	for i, j := 0, 0; i < len(a) || j < len(b); {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, " "+a[i])
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, "-"+a[i])
+			i++
+		default:
+			lines = append(lines, "+"+b[j])
+			j++
+		}
+	}
+	// Keep only the changes and their context.
+	keep := make([]bool, len(lines))
+	for i, line := range lines {
+		if line[0] == ' ' {
+			continue
+		}
+		for k := max(0, i-diffContext); k <= min(len(lines)-1, i+diffContext); k++ {
+			keep[k] = true
+		}
+	}
+	var out strings.Builder
+	skipped := false
+	for i, line := range lines {
+		if !keep[i] {
+			skipped = true
+			continue
+		}
+		if skipped && out.Len() > 0 {
+			out.WriteString("...\n")
+		}
+		skipped = false
+		out.WriteString(line)
+		out.WriteByte('\n')
+	}
+	return out.String()
+}
@@ -0,0 +1,99 @@
+package godb
+
+import (
+	"fmt"
+	"time"
+)
+
+// timeLayout is RFC 3339 in UTC with a fixed six-digit fraction, matching the
+// microsecond precision of typed timestamps. The fixed width keeps stored
+// strings in chronological order when compared as text.
+const timeLayout = "2006-01-02T15:04:05.000000Z07:00"
+
+// timeLayouts are accepted when parsing timestamps from query results.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// formatTime renders t as it is sent to the server.
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+// parseTime parses a timestamp in RFC 3339 or a common SQL form. Timestamps
+// without a zone are taken as UTC.
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized timestamp format")
+}
+
+// timeCond compares a field with a time, computed at render time when since
+// is set so reused builders keep a sliding window.
+type timeCond struct {
+	field    string
+	operator string
+	at       time.Time
+	since    time.Duration
+}
+
+func (c timeCond) render() (string, error) {
+	at := c.at
+	if c.since != 0 {
+		at = time.Now().Add(-c.since)
+	}
+	return fmt.Sprintf("%s %s %s", c.field, c.operator, quoteString(formatTime(at))), nil
+}
+
+// Before matches rows where the time in field is earlier than t.
+func Before(field string, t time.Time) Cond {
+	return timeCond{field: field, operator: "<", at: t}
+}
+
+// After matches rows where the time in field is later than t.
+func After(field string, t time.Time) Cond {
+	return timeCond{field: field, operator: ">", at: t}
+}
+
+// WithinLast matches rows where the time in field is no older than d, measured
+// when the builder executes.
+func WithinLast(field string, d time.Duration) Cond {
+	return timeCond{field: field, operator: ">=", since: d}
+}
+
+// Before adds a condition matching rows where the time in field is earlier than t.
+func (qb *QueryBuilder) Before(field string, t time.Time) *QueryBuilder {
+	return qb.Where(Before(field, t))
+}
+
+// After adds a condition matching rows where the time in field is later than t.
+func (qb *QueryBuilder) After(field string, t time.Time) *QueryBuilder {
+	return qb.Where(After(field, t))
+}
+
+// WithinLast adds a condition matching rows where the time in field is no older than d.
+func (qb *QueryBuilder) WithinLast(field string, d time.Duration) *QueryBuilder {
+	return qb.Where(WithinLast(field, d))
+}
+
+// Before adds a condition matching rows where the time in field is earlier than t.
+func (urb *UpdateRecordBuilder) Before(field string, t time.Time) *UpdateRecordBuilder {
+	return urb.Where(Before(field, t))
+}
+
+// After adds a condition matching rows where the time in field is later than t.
+func (urb *UpdateRecordBuilder) After(field string, t time.Time) *UpdateRecordBuilder {
+	return urb.Where(After(field, t))
+}
+
+// WithinLast adds a condition matching rows where the time in field is no older than d.
+func (urb *UpdateRecordBuilder) WithinLast(field string, d time.Duration) *UpdateRecordBuilder {
+	return urb.Where(WithinLast(field, d))
+}
@@ -0,0 +1,87 @@
+package godb
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// WithDefaultTimeout applies a deadline of d to every RPC whose context has no
+// deadline of its own, so calls made with context.Background() cannot hang forever
+// on an unreachable server. Builders can override it per call with Timeout.
+func WithDefaultTimeout(d time.Duration) Option {
+	return func(o *clientOptions) {
+		o.defaultTimeout = d
+	}
+}
+
+// defaultTimeoutInterceptor returns an interceptor adding a deadline of d to
+// calls whose context has none.
+func defaultTimeoutInterceptor(d time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, d)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// withTimeout derives a context with a deadline of d from ctx. A non-positive d
+// leaves ctx unchanged.
+func withTimeout(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	if d <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, d)
+}
+
+// DeadlineWarning describes a call that used much of its deadline.
+type DeadlineWarning struct {
+	Method  string
+	Table   string
+	Elapsed time.Duration
+	// Budget is the time the call had between starting and its deadline.
+	Budget time.Duration
+	// Err is the call's error, if any.
+	Err error
+}
+
+// Fraction returns the share of the budget the call used.
+func (w DeadlineWarning) Fraction() float64 {
+	return float64(w.Elapsed) / float64(w.Budget)
+}
+
+// WithDeadlineWarning calls notify for each RPC with a deadline that took at
+// least fraction of the time left to it, e.g. 0.8 for 80%, to find calls that
+// are close to timing out before they do. Deadlines set by WithDefaultTimeout
+// count. notify runs on the calling goroutine and should not block.
+func WithDeadlineWarning(fraction float64, notify func(DeadlineWarning)) Option {
+	return func(o *clientOptions) {
+		o.deadlineWarning = deadlineWarningInterceptor(fraction, notify)
+	}
+}
+
+// deadlineWarningInterceptor returns an interceptor reporting calls that used
+// at least fraction of their deadline to notify.
+func deadlineWarningInterceptor(fraction float64, notify func(DeadlineWarning)) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		w := DeadlineWarning{Method: method, Elapsed: time.Since(start), Budget: deadline.Sub(start), Err: err}
+		if w.Budget > 0 && w.Fraction() < fraction {
+			return err
+		}
+		if r, ok := req.(interface{ GetTableName() string }); ok {
+			w.Table = r.GetTableName()
+		}
+		notify(w)
+		return err
+	}
+}
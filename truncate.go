@@ -0,0 +1,46 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// TruncateOption configures TruncateTable.
+type TruncateOption func(*proto.TruncateTableRequest)
+
+// ResetSequences restarts the table's AUTOINCREMENT and sequence ids from 1.
+func ResetSequences() TruncateOption {
+	return func(r *proto.TruncateTableRequest) { r.ResetSequences = true }
+}
+
+// TruncateTable deletes every row of table in the client's database and
+// returns the number of rows deleted. It is much faster than a Delete with
+// DeleteAll on large tables since the server drops the rows wholesale instead
+// of matching them one by one.
+func (c *GoDBClient) TruncateTable(ctx context.Context, table string, opts ...TruncateOption) (int64, error) {
+	return c.truncateTable(ctx, c.connectionStringFor(ctx), table, opts)
+}
+
+// Truncate deletes every row of the table, as GoDBClient.TruncateTable.
+func (t *Table) Truncate(ctx context.Context, opts ...TruncateOption) (int64, error) {
+	return t.client.truncateTable(ctx, t.connString(ctx), t.name, opts)
+}
+
+func (c *GoDBClient) truncateTable(ctx context.Context, connStr, table string, opts []TruncateOption) (_ int64, err error) {
+	defer wrapOpError(&err, "TruncateTable", table, proto.DatabaseService_TruncateTable_FullMethodName, time.Now())
+	if table == "" {
+		return 0, fmt.Errorf("table name is required")
+	}
+	req := &proto.TruncateTableRequest{ConnectionString: connStr, TableName: table}
+	for _, opt := range opts {
+		opt(req)
+	}
+	resp, err := c.client.TruncateTable(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	return resp.RowsDeleted, nil
+}
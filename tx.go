@@ -0,0 +1,147 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// txMetadataKey carries the transaction id of a call.
+const txMetadataKey = "godb-transaction-id"
+
+// Tx is a server-side transaction. Calls made with a context from
+// NewContextWithTx run inside it, whichever builder or helper makes them.
+type Tx struct {
+	client *GoDBClient
+	id     string
+
+	mu       sync.Mutex
+	done     bool
+	onFinish []func(ctx context.Context)
+}
+
+// Begin starts a transaction using the client's stored connection string.
+func (c *GoDBClient) Begin(ctx context.Context) (_ *Tx, err error) {
+	defer wrapOpError(&err, "Begin", "", proto.DatabaseService_BeginTransaction_FullMethodName, time.Now())
+	resp, err := c.client.BeginTransaction(ctx, &proto.BeginTransactionRequest{ConnectionString: c.connectionStringFor(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{client: c, id: resp.TransactionId}, nil
+}
+
+// ID returns the server's transaction id.
+func (tx *Tx) ID() string {
+	return tx.id
+}
+
+// Commit makes the transaction's changes visible.
+func (tx *Tx) Commit(ctx context.Context) (err error) {
+	defer wrapOpError(&err, "Commit", "", proto.DatabaseService_CommitTransaction_FullMethodName, time.Now())
+	if err := tx.finish(); err != nil {
+		return err
+	}
+	_, err = tx.client.client.CommitTransaction(ctx, &proto.CommitTransactionRequest{TransactionId: tx.id})
+	tx.runFinishHooks(ctx)
+	return err
+}
+
+// Rollback discards the transaction's changes. Rolling back a finished
+// transaction does nothing, so it is safe to defer.
+func (tx *Tx) Rollback(ctx context.Context) (err error) {
+	defer wrapOpError(&err, "Rollback", "", proto.DatabaseService_RollbackTransaction_FullMethodName, time.Now())
+	if tx.finish() != nil {
+		return nil
+	}
+	_, err = tx.client.client.RollbackTransaction(ctx, &proto.RollbackTransactionRequest{TransactionId: tx.id})
+	tx.runFinishHooks(ctx)
+	return err
+}
+
+// finish marks the transaction done, failing if it already was.
+func (tx *Tx) finish() error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	if tx.done {
+		return fmt.Errorf("transaction already committed or rolled back")
+	}
+	tx.done = true
+	return nil
+}
+
+// afterFinish registers fn to run once the transaction is committed or rolled
+// back, whether or not the server confirmed it.
+func (tx *Tx) afterFinish(fn func(ctx context.Context)) {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	tx.onFinish = append(tx.onFinish, fn)
+}
+
+// runFinishHooks runs the functions registered with afterFinish, even if ctx
+// is already cancelled.
+func (tx *Tx) runFinishHooks(ctx context.Context) {
+	tx.mu.Lock()
+	hooks := tx.onFinish
+	tx.onFinish = nil
+	tx.mu.Unlock()
+	ctx = context.WithoutCancel(ctx)
+	for _, fn := range hooks {
+		fn(ctx)
+	}
+}
+
+// txContextKey is the context key of the ambient transaction.
+type txContextKey struct{}
+
+// NewContextWithTx returns a context carrying tx. Builders and helpers given
+// this context run their calls inside tx, so code below the service layer
+// need not know whether it is in a transaction.
+func NewContextWithTx(ctx context.Context, tx *Tx) context.Context {
+	return context.WithValue(ctx, txContextKey{}, tx)
+}
+
+// TxFromContext returns the transaction carried by ctx, if any.
+func TxFromContext(ctx context.Context) (*Tx, bool) {
+	tx, ok := ctx.Value(txContextKey{}).(*Tx)
+	return tx, ok
+}
+
+// RunInTx calls fn with a context carrying a transaction, committing it if fn
+// succeeds and rolling it back otherwise. If ctx already carries a
+// transaction, fn joins it and the outermost RunInTx decides the outcome.
+func (c *GoDBClient) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+	tx, err := c.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+	if err := fn(NewContextWithTx(ctx, tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// txUnaryInterceptor sends the ambient transaction's id with unary calls.
+func txUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if tx, ok := TxFromContext(ctx); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx, txMetadataKey, tx.id)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// txStreamInterceptor sends the ambient transaction's id with streams.
+func txStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if tx, ok := TxFromContext(ctx); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx, txMetadataKey, tx.id)
+	}
+	return streamer(ctx, desc, cc, method, opts...)
+}
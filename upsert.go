@@ -0,0 +1,166 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// UpsertBuilder provides a fluent interface for an insert-on-conflict-update operation.
+type UpsertBuilder struct {
+	client           *GoDBClient
+	ctx              context.Context
+	connectionString string
+	tableName        string
+	record           map[string]string
+	typed            map[string]*proto.Value
+	conflictColumns  []string
+	updateColumns    []string
+	doNothing        bool
+	returning        []string
+	err              error
+	timeout          time.Duration
+}
+
+// Upsert returns a new UpsertBuilder using the client's stored connection string.
+func (client *GoDBClient) Upsert(ctx context.Context) *UpsertBuilder {
+	return &UpsertBuilder{
+		client:           client,
+		ctx:              ctx,
+		connectionString: client.connectionStringFor(ctx),
+		record:           make(map[string]string),
+	}
+}
+
+// Table sets the table name for the UpsertBuilder.
+func (ub *UpsertBuilder) Table(table string) *UpsertBuilder {
+	ub.tableName = table
+	return ub
+}
+
+// Values sets the record values.
+func (ub *UpsertBuilder) Values(record map[string]string) *UpsertBuilder {
+	ub.record = record
+	ub.typed = nil
+	return ub
+}
+
+// Model sets the record values from a struct whose fields carry `godb:"column"` tags.
+func (ub *UpsertBuilder) Model(model interface{}) *UpsertBuilder {
+	var typed map[string]*proto.Value
+	record, err := guarded(ub.client, func() (record map[string]string, err error) {
+		record, typed, err = encodeModel(ub.client.recordCodec(), model)
+		return record, err
+	})
+	if err != nil {
+		ub.err = err
+		return ub
+	}
+	ub.record, ub.typed = record, typed
+	return ub
+}
+
+// Set sets a single column value, encoding it like InsertBuilder.Set.
+func (ub *UpsertBuilder) Set(column string, value interface{}) *UpsertBuilder {
+	if ub.record == nil {
+		ub.record = make(map[string]string)
+	}
+	if ub.typed == nil {
+		ub.typed = make(map[string]*proto.Value)
+	}
+	if err := setColumn(ub.client, ub.record, ub.typed, column, value); err != nil {
+		ub.err = err
+	}
+	return ub
+}
+
+// OnConflict sets the columns whose uniqueness decides whether the row already exists.
+func (ub *UpsertBuilder) OnConflict(columns ...string) *UpsertBuilder {
+	ub.conflictColumns = append(ub.conflictColumns, columns...)
+	return ub
+}
+
+// DoUpdate sets the columns overwritten with the new values when a conflict occurs.
+// Calling it without columns updates every non-conflict column of the record.
+func (ub *UpsertBuilder) DoUpdate(columns ...string) *UpsertBuilder {
+	ub.updateColumns = append(ub.updateColumns, columns...)
+	ub.doNothing = false
+	return ub
+}
+
+// DoNothing keeps the existing row untouched when a conflict occurs.
+func (ub *UpsertBuilder) DoNothing() *UpsertBuilder {
+	ub.updateColumns = nil
+	ub.doNothing = true
+	return ub
+}
+
+// Returning names columns of the inserted or updated row, such as generated
+// ids and timestamps, to return in the Result of ExecResult.
+func (ub *UpsertBuilder) Returning(columns ...string) *UpsertBuilder {
+	ub.returning = append(ub.returning, columns...)
+	return ub
+}
+
+// Timeout sets a deadline for this operation, overriding the client's default timeout.
+func (ub *UpsertBuilder) Timeout(d time.Duration) *UpsertBuilder {
+	ub.timeout = d
+	return ub
+}
+
+// Exec executes the upsert operation.
+func (ub *UpsertBuilder) Exec() (string, error) {
+	resp, err := ub.exec()
+	if err != nil {
+		return "", err
+	}
+	return resp.Message, nil
+}
+
+// ExecResult executes the upsert operation and reports its effect. InsertedIDs
+// is empty when an existing row was updated.
+func (ub *UpsertBuilder) ExecResult() (Result, error) {
+	resp, err := ub.exec()
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{RowsAffected: resp.RowsAffected, InsertedIDs: insertedIDs(resp.InsertedId), Message: resp.Message, Returned: resp.Returned}, nil
+}
+
+// exec sends the UpsertRecord request.
+func (ub *UpsertBuilder) exec() (_ *proto.UpsertRecordResponse, err error) {
+	defer wrapOpError(&err, "Upsert", ub.tableName, proto.DatabaseService_UpsertRecord_FullMethodName, time.Now())
+	if ub.err != nil {
+		return nil, ub.err
+	}
+	if ub.tableName == "" {
+		return nil, fmt.Errorf("table name is required")
+	}
+	if len(ub.record) == 0 {
+		return nil, fmt.Errorf("no record provided")
+	}
+	if len(ub.conflictColumns) == 0 {
+		return nil, fmt.Errorf("conflict columns are required")
+	}
+	for _, col := range ub.conflictColumns {
+		if _, ok := ub.record[col]; !ok {
+			return nil, fmt.Errorf("conflict column %q missing from record", col)
+		}
+	}
+	record, typed := extractNulls(ub.record, ub.typed)
+	req := &proto.UpsertRecordRequest{
+		TableName:        ub.tableName,
+		Record:           record,
+		ConflictColumns:  ub.conflictColumns,
+		UpdateColumns:    ub.updateColumns,
+		DoNothing:        ub.doNothing,
+		ConnectionString: ub.connectionString,
+		TypedRecord:      typed,
+		Returning:        ub.returning,
+	}
+	ctx, cancel := withTimeout(ub.ctx, ub.timeout)
+	defer cancel()
+	return ub.client.client.UpsertRecord(ctx, req)
+}
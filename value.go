@@ -0,0 +1,252 @@
+package godb
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// encodeColumn encodes a column value as its wire string and, for numbers,
+// booleans, byte slices, and times, as a typed value. Strings and registered
+// types are sent as strings only.
+func encodeColumn(value interface{}) (string, *proto.Value, error) {
+	if value != nil {
+		if rv := reflect.ValueOf(value); rv.Kind() == reflect.Ptr && !rv.IsNil() {
+			if _, ok := lookupCodec(rv.Type()); !ok {
+				value = rv.Elem().Interface()
+			}
+		}
+	}
+	encoded, err := encodeValue(value)
+	if err != nil {
+		return "", nil, err
+	}
+	if _, ok := lookupCodec(reflect.TypeOf(value)); ok {
+		return encoded, nil, nil
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Bool:
+		return encoded, &proto.Value{Kind: &proto.Value_BoolValue{BoolValue: rv.Bool()}}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return encoded, &proto.Value{Kind: &proto.Value_IntValue{IntValue: rv.Int()}}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() > math.MaxInt64 {
+			return encoded, nil, nil
+		}
+		return encoded, &proto.Value{Kind: &proto.Value_IntValue{IntValue: int64(rv.Uint())}}, nil
+	case reflect.Float32, reflect.Float64:
+		return encoded, &proto.Value{Kind: &proto.Value_DoubleValue{DoubleValue: rv.Float()}}, nil
+	case reflect.Slice:
+		if b, ok := value.([]byte); ok {
+			return base64.StdEncoding.EncodeToString(b), &proto.Value{Kind: &proto.Value_BytesValue{BytesValue: b}}, nil
+		}
+	case reflect.Struct:
+		if t, ok := value.(time.Time); ok {
+			return formatTime(t), &proto.Value{Kind: &proto.Value_TimestampMicros{TimestampMicros: t.UnixMicro()}}, nil
+		}
+	}
+	return encoded, nil, nil
+}
+
+// setColumn encodes value with the client's codec into record and, when it has
+// a typed form, typed.
+func setColumn(c *GoDBClient, record map[string]string, typed map[string]*proto.Value, column string, value interface{}) error {
+	if isNull(value) {
+		record[column] = Null
+		delete(typed, column)
+		return nil
+	}
+	var v *proto.Value
+	encoded, err := guarded(c, func() (encoded string, err error) {
+		encoded, v, err = c.recordCodec().EncodeColumn(value)
+		return encoded, err
+	})
+	if err != nil {
+		return err
+	}
+	record[column] = encoded
+	if v != nil {
+		typed[column] = v
+	} else {
+		delete(typed, column)
+	}
+	return nil
+}
+
+// valueString returns the string form of a typed value, as servers without
+// typed values would send it. Nulls have none.
+func valueString(v *proto.Value) (string, bool) {
+	switch k := v.GetKind().(type) {
+	case *proto.Value_StringValue:
+		return k.StringValue, true
+	case *proto.Value_IntValue:
+		return strconv.FormatInt(k.IntValue, 10), true
+	case *proto.Value_DoubleValue:
+		return strconv.FormatFloat(k.DoubleValue, 'g', -1, 64), true
+	case *proto.Value_BoolValue:
+		return strconv.FormatBool(k.BoolValue), true
+	case *proto.Value_BytesValue:
+		return base64.StdEncoding.EncodeToString(k.BytesValue), true
+	case *proto.Value_TimestampMicros:
+		return formatTime(time.UnixMicro(k.TimestampMicros)), true
+	}
+	return "", false
+}
+
+// goValue returns a typed value as a Go value: string, int64, float64, bool,
+// []byte, time.Time, or nil.
+func goValue(v *proto.Value) interface{} {
+	switch k := v.GetKind().(type) {
+	case *proto.Value_StringValue:
+		return k.StringValue
+	case *proto.Value_IntValue:
+		return k.IntValue
+	case *proto.Value_DoubleValue:
+		return k.DoubleValue
+	case *proto.Value_BoolValue:
+		return k.BoolValue
+	case *proto.Value_BytesValue:
+		return k.BytesValue
+	case *proto.Value_TimestampMicros:
+		return time.UnixMicro(k.TimestampMicros).UTC()
+	}
+	return nil
+}
+
+// RowValues returns the row's values as Go values: int64, float64, bool,
+// []byte, time.Time, or nil for NULL where the server sent typed values, and
+// the string form otherwise.
+func RowValues(row *proto.QueryRow) map[string]interface{} {
+	out := make(map[string]interface{}, len(row.Data)+len(row.Values))
+	for col, v := range row.Data {
+		out[col] = v
+	}
+	for col, v := range row.Values {
+		out[col] = goValue(v)
+	}
+	return out
+}
+
+// decodeColumn decodes a row's column into v, preferring its typed value.
+// ok is false when the row has neither form of the column.
+func decodeColumn(row *proto.QueryRow, column string, v reflect.Value) (ok bool, err error) {
+	if typed, found := row.Values[column]; found {
+		return true, decodeValueInto(typed, v)
+	}
+	raw, found := row.Data[column]
+	if !found {
+		return false, nil
+	}
+	return true, decodeInto(raw, v)
+}
+
+// decodeValueInto sets v from a typed value, falling back to the string form
+// when the types do not match directly.
+func decodeValueInto(val *proto.Value, v reflect.Value) error {
+	if _, null := val.GetKind().(*proto.Value_NullValue); null {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	if _, ok := lookupCodec(v.Type()); !ok {
+		if v.Kind() == reflect.Ptr {
+			elem := reflect.New(v.Type().Elem())
+			if err := decodeValueInto(val, elem.Elem()); err != nil {
+				return err
+			}
+			v.Set(elem)
+			return nil
+		}
+		switch k := val.GetKind().(type) {
+		case *proto.Value_IntValue:
+			switch v.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				if v.OverflowInt(k.IntValue) {
+					return fmt.Errorf("value %d overflows %s", k.IntValue, v.Type())
+				}
+				v.SetInt(k.IntValue)
+				return nil
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				if k.IntValue < 0 || v.OverflowUint(uint64(k.IntValue)) {
+					return fmt.Errorf("value %d overflows %s", k.IntValue, v.Type())
+				}
+				v.SetUint(uint64(k.IntValue))
+				return nil
+			case reflect.Float32, reflect.Float64:
+				v.SetFloat(float64(k.IntValue))
+				return nil
+			}
+		case *proto.Value_DoubleValue:
+			if v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
+				v.SetFloat(k.DoubleValue)
+				return nil
+			}
+		case *proto.Value_BoolValue:
+			if v.Kind() == reflect.Bool {
+				v.SetBool(k.BoolValue)
+				return nil
+			}
+		case *proto.Value_BytesValue:
+			if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+				v.SetBytes(append([]byte(nil), k.BytesValue...))
+				return nil
+			}
+		case *proto.Value_TimestampMicros:
+			if v.Type() == timeType {
+				v.Set(reflect.ValueOf(time.UnixMicro(k.TimestampMicros).UTC()))
+				return nil
+			}
+		}
+	}
+	s, _ := valueString(val)
+	return decodeInto(s, v)
+}
+
+// typedValuesInterceptor fills the string form of typed values in query
+// results, update images, and returned rows, so code reading a row's Data works whichever form
+// the server sent.
+func typedValuesInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+		return err
+	}
+	if resp, ok := reply.(*proto.QueryDataResponse); ok {
+		for _, row := range resp.Rows {
+			fillStrings(row)
+		}
+	}
+	for _, row := range changeImages(reply) {
+		fillStrings(row)
+	}
+	for _, row := range returnedRows(reply) {
+		fillStrings(row)
+	}
+	return nil
+}
+
+// fillStrings sets the string form of the row's typed values it lacks.
+func fillStrings(row *proto.QueryRow) {
+	if row == nil {
+		return
+	}
+	for col, v := range row.Values {
+		if _, ok := row.Data[col]; ok {
+			continue
+		}
+		if s, ok := valueString(v); ok {
+			if row.Data == nil {
+				row.Data = make(map[string]string, len(row.Values))
+			}
+			row.Data[col] = s
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package godb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Version is the SDK release version.
+const Version = "1.1.0"
+
+// versionMetadataKey is the gRPC metadata key carrying the SDK version on every call.
+const versionMetadataKey = "godb-sdk-version"
+
+// ErrIncompatibleVersion is matched by errors.Is when the server and SDK versions
+// are incompatible.
+var ErrIncompatibleVersion = errors.New("incompatible GoDB server version")
+
+// VersionError reports an SDK/server version mismatch detected via Capabilities.
+type VersionError struct {
+	SDKVersion       string
+	ServerVersion    string
+	MinSDKVersion    string
+	MinServerVersion string
+}
+
+// Error implements the error interface.
+func (e *VersionError) Error() string {
+	if e.MinServerVersion != "" && compareVersions(e.ServerVersion, e.MinServerVersion) < 0 {
+		return fmt.Sprintf("GoDB server %s is older than the required %s", e.ServerVersion, e.MinServerVersion)
+	}
+	return fmt.Sprintf("GoDB SDK %s is older than the server minimum %s", e.SDKVersion, e.MinSDKVersion)
+}
+
+// Is makes VersionError match ErrIncompatibleVersion.
+func (e *VersionError) Is(target error) bool {
+	return target == ErrIncompatibleVersion
+}
+
+// WithVersionCheck makes the client query the server's Capabilities before its first
+// call and fail every call with a *VersionError when the SDK is older than the
+// server's minimum SDK version, or the server is older than minServerVersion
+// (pass "" to skip that check). Servers that do not implement Capabilities are
+// assumed compatible.
+func WithVersionCheck(minServerVersion string) Option {
+	return func(o *clientOptions) {
+		o.versionCheck = &versionCheck{minServerVersion: minServerVersion}
+	}
+}
+
+// Capabilities reports the server version, the oldest SDK version it supports,
+// and the optional features it provides.
+func (c *GoDBClient) Capabilities(ctx context.Context) (_ *proto.CapabilitiesResponse, err error) {
+	defer wrapOpError(&err, "Capabilities", "", proto.DatabaseService_Capabilities_FullMethodName, time.Now())
+	return c.client.Capabilities(ctx, &proto.CapabilitiesRequest{SdkVersion: Version})
+}
+
+// versionUnaryInterceptor attaches the SDK version to outgoing unary calls.
+func versionUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, versionMetadataKey, Version)
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// versionStreamInterceptor attaches the SDK version to outgoing streams.
+func versionStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, versionMetadataKey, Version)
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+// versionCheck verifies compatibility once, before the first call that needs it.
+type versionCheck struct {
+	minServerVersion string
+
+	mu      sync.Mutex
+	checked bool
+	err     error
+}
+
+// verify runs the Capabilities check if it has not succeeded yet. Transport
+// failures are not cached, so the check is retried on the next call.
+func (v *versionCheck) verify(ctx context.Context, cc *grpc.ClientConn) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.checked {
+		return v.err
+	}
+	resp, err := proto.NewDatabaseServiceClient(cc).Capabilities(ctx, &proto.CapabilitiesRequest{SdkVersion: Version})
+	if status.Code(err) == codes.Unimplemented {
+		v.checked = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	v.checked = true
+	mismatch := &VersionError{
+		SDKVersion:       Version,
+		ServerVersion:    resp.ServerVersion,
+		MinSDKVersion:    resp.MinSdkVersion,
+		MinServerVersion: v.minServerVersion,
+	}
+	if resp.MinSdkVersion != "" && compareVersions(Version, resp.MinSdkVersion) < 0 {
+		v.err = mismatch
+	}
+	if v.minServerVersion != "" && compareVersions(resp.ServerVersion, v.minServerVersion) < 0 {
+		v.err = mismatch
+	}
+	return v.err
+}
+
+// unaryInterceptor fails calls while the server is incompatible.
+func (v *versionCheck) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if method != proto.DatabaseService_Capabilities_FullMethodName {
+		if err := v.verify(ctx, cc); err != nil {
+			return err
+		}
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// streamInterceptor fails streams while the server is incompatible.
+func (v *versionCheck) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := v.verify(ctx, cc); err != nil {
+		return nil, err
+	}
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+// compareVersions compares dotted numeric versions such as "1.2.0", ignoring a
+// leading "v" and any pre-release suffix. It returns -1, 0, or 1.
+func compareVersions(a, b string) int {
+	pa, pb := versionParts(a), versionParts(b)
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var x, y int
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
+
+// versionParts splits a version string into its numeric components.
+func versionParts(v string) []int {
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	var parts []int
+	for _, p := range strings.Split(v, ".") {
+		n, _ := strconv.Atoi(p)
+		parts = append(parts, n)
+	}
+	return parts
+}
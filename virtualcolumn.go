@@ -0,0 +1,204 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+
+	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// VirtualColumn is a column of Table that is not stored but computed on the
+// client from the columns in Uses whenever rows are read, e.g. full_name from
+// first_name and last_name. Rows carry it like any other column, so struct
+// scanning, RowValues, and result sinks see it. It is computed for queries
+// selecting every column or naming it; its value is dropped from writes.
+type VirtualColumn struct {
+	Table string
+	Name  string
+	Uses  []string
+	// Compute returns the column's value from the row's stored columns. Columns
+	// that are NULL are absent from row.
+	Compute func(row map[string]string) (string, error)
+}
+
+// WithVirtualColumn registers vc for every query of its table.
+func WithVirtualColumn(vc VirtualColumn) Option {
+	return func(o *clientOptions) {
+		if o.virtualColumns == nil {
+			o.virtualColumns = make(virtualColumns)
+		}
+		o.virtualColumns[vc.Table] = append(o.virtualColumns[vc.Table], vc)
+	}
+}
+
+// virtualColumns holds the virtual columns of each table.
+type virtualColumns map[string][]VirtualColumn
+
+// selectColumns replaces virtual columns in an explicit select list with the
+// columns they use. It returns the columns to send, the virtual columns to
+// compute, and the columns added only to compute them.
+func (vcs virtualColumns) selectColumns(table, columns string) (string, []VirtualColumn, []string) {
+	if strings.TrimSpace(columns) == "" || strings.TrimSpace(columns) == "*" {
+		return columns, vcs[table], nil
+	}
+	var sent []string
+	selected := make(map[string]bool)
+	for _, col := range strings.Split(columns, ",") {
+		col = strings.TrimSpace(col)
+		selected[col] = true
+	}
+	var compute []VirtualColumn
+	virtual := make(map[string]bool)
+	for _, vc := range vcs[table] {
+		if selected[vc.Name] {
+			compute = append(compute, vc)
+			virtual[vc.Name] = true
+		}
+	}
+	if len(compute) == 0 {
+		return columns, nil, nil
+	}
+	for _, col := range strings.Split(columns, ",") {
+		if col = strings.TrimSpace(col); !virtual[col] {
+			sent = append(sent, col)
+		}
+	}
+	var added []string
+	for _, vc := range compute {
+		for _, col := range vc.Uses {
+			if !selected[col] {
+				sent = append(sent, col)
+				added = append(added, col)
+				selected[col] = true
+			}
+		}
+	}
+	return strings.Join(sent, ", "), compute, added
+}
+
+// dropVirtual returns rec and typed without virtual columns of table, copying
+// them when they change.
+func (vcs virtualColumns) dropVirtual(table string, rec map[string]string, typed map[string]*proto.Value) (map[string]string, map[string]*proto.Value) {
+	for _, vc := range vcs[table] {
+		if _, ok := rec[vc.Name]; ok {
+			rec = copyRow(rec, 0)
+			delete(rec, vc.Name)
+		}
+		if _, ok := typed[vc.Name]; ok {
+			next := make(map[string]*proto.Value, len(typed))
+			for col, v := range typed {
+				next[col] = v
+			}
+			delete(next, vc.Name)
+			typed = next
+		}
+	}
+	return rec, typed
+}
+
+// interceptor strips virtual columns from writes and computes them in query
+// results.
+func (vcs virtualColumns) interceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	tr, ok := req.(tableRequest)
+	if !ok || vcs[tr.GetTableName()] == nil {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	table := tr.GetTableName()
+	var compute []VirtualColumn
+	var added []string
+	switch r := req.(type) {
+	case *proto.InsertRecordRequest:
+		r = protobuf.Clone(r).(*proto.InsertRecordRequest)
+		r.Record, r.TypedRecord = vcs.dropVirtual(table, r.Record, r.TypedRecord)
+		req = r
+	case *proto.InsertMultipleRecordsRequest:
+		r = protobuf.Clone(r).(*proto.InsertMultipleRecordsRequest)
+		for _, rec := range r.Records {
+			rec.Data, rec.TypedData = vcs.dropVirtual(table, rec.Data, rec.TypedData)
+		}
+		req = r
+	case *proto.UpsertRecordRequest:
+		r = protobuf.Clone(r).(*proto.UpsertRecordRequest)
+		r.Record, r.TypedRecord = vcs.dropVirtual(table, r.Record, r.TypedRecord)
+		req = r
+	case *proto.UpdateRecordRequest:
+		r = protobuf.Clone(r).(*proto.UpdateRecordRequest)
+		r.Updates, r.TypedUpdates = vcs.dropVirtual(table, r.Updates, r.TypedUpdates)
+		req = r
+	case *proto.QueryDataRequest:
+		var columns string
+		columns, compute, added = vcs.selectColumns(table, r.Columns)
+		if columns != r.Columns {
+			r = protobuf.Clone(r).(*proto.QueryDataRequest)
+			r.Columns = columns
+			req = r
+		}
+	}
+	if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+		return err
+	}
+	// Update images are whole rows, so every virtual column can be computed.
+	for _, row := range changeImages(reply) {
+		if err := computeVirtual(row, vcs[table]); err != nil {
+			return err
+		}
+	}
+	resp, ok := reply.(*proto.QueryDataResponse)
+	if !ok || len(compute) == 0 {
+		return nil
+	}
+	for _, row := range resp.Rows {
+		if err := computeVirtual(row, compute); err != nil {
+			return err
+		}
+		for _, col := range added {
+			delete(row.Data, col)
+			delete(row.Values, col)
+		}
+	}
+	if len(resp.Columns) > 0 {
+		resp.Columns = virtualColumnInfo(resp.Columns, compute, added)
+	}
+	return nil
+}
+
+// computeVirtual sets the value of each column in compute on row.
+func computeVirtual(row *proto.QueryRow, compute []VirtualColumn) error {
+	if row.Data == nil {
+		row.Data = make(map[string]string, len(compute))
+	}
+	for _, vc := range compute {
+		v, err := vc.Compute(row.Data)
+		if err != nil {
+			return fmt.Errorf("virtual column %s: %w", vc.Name, err)
+		}
+		row.Data[vc.Name] = v
+	}
+	return nil
+}
+
+// virtualColumnInfo describes the computed columns in place of those added to
+// compute them.
+func virtualColumnInfo(columns []*proto.ColumnInfo, compute []VirtualColumn, added []string) []*proto.ColumnInfo {
+	drop := make(map[string]bool, len(added)+len(compute))
+	for _, col := range added {
+		drop[col] = true
+	}
+	for _, vc := range compute {
+		drop[vc.Name] = true
+	}
+	out := make([]*proto.ColumnInfo, 0, len(columns)+len(compute))
+	for _, col := range columns {
+		if !drop[col.Name] {
+			out = append(out, col)
+		}
+	}
+	for _, vc := range compute {
+		out = append(out, &proto.ColumnInfo{Name: vc.Name, Type: "TEXT", Nullable: true})
+	}
+	return out
+}
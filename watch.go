@@ -0,0 +1,132 @@
+package godb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prakhar-5447/GoDB_SDK_GO/proto"
+)
+
+// Kinds of change reported by Watch.
+const (
+	ChangeInsert = proto.ChangeType_CHANGE_TYPE_INSERT
+	ChangeUpdate = proto.ChangeType_CHANGE_TYPE_UPDATE
+	ChangeDelete = proto.ChangeType_CHANGE_TYPE_DELETE
+)
+
+// ChangeEvent is one change to a watched row. Old is nil for inserts and New
+// is nil for deletes. A row updated so it no longer matches the watch
+// condition is reported as a delete, and one updated so it starts matching as
+// an insert.
+type ChangeEvent struct {
+	Type proto.ChangeType
+	Key  string
+	Old  map[string]string
+	New  map[string]string
+}
+
+// WatchBuilder provides a fluent interface for subscribing to changes.
+type WatchBuilder struct {
+	client           *GoDBClient
+	ctx              context.Context
+	connectionString string
+	tableName        string
+	conds            []Cond
+	keyColumn        string
+}
+
+// Watch returns a new WatchBuilder using the client's stored connection string.
+// The subscription lasts until ctx is done.
+func (client *GoDBClient) Watch(ctx context.Context) *WatchBuilder {
+	return &WatchBuilder{
+		client:           client,
+		ctx:              ctx,
+		connectionString: client.connectionStringFor(ctx),
+		keyColumn:        "id",
+	}
+}
+
+// Table sets the table name.
+func (wb *WatchBuilder) Table(table string) *WatchBuilder {
+	wb.tableName = table
+	return wb
+}
+
+// Condition sets a custom WHERE condition, replacing any conditions added so far.
+// Named parameters written :name take their values from params, as with Named.
+func (wb *WatchBuilder) Condition(cond string, params ...Params) *WatchBuilder {
+	wb.conds = []Cond{conditionText(cond, params)}
+	return wb
+}
+
+// Where adds a condition expression, ANDed with the existing conditions.
+func (wb *WatchBuilder) Where(cond Cond) *WatchBuilder {
+	wb.conds = append(wb.conds, cond)
+	return wb
+}
+
+// Equal adds an equality condition.
+func (wb *WatchBuilder) Equal(field string, value interface{}) *WatchBuilder {
+	return wb.Where(Eq(field, value))
+}
+
+// Key sets the column identifying rows in events. The default is "id".
+func (wb *WatchBuilder) Key(column string) *WatchBuilder {
+	wb.keyColumn = column
+	return wb
+}
+
+// Stream subscribes to changes of the rows matching the conditions, or of
+// every row when there are none.
+func (wb *WatchBuilder) Stream() (_ *ChangeStream, err error) {
+	defer wrapOpError(&err, "Watch", wb.tableName, proto.DatabaseService_Watch_FullMethodName, time.Now())
+	if wb.tableName == "" {
+		return nil, fmt.Errorf("table name is required")
+	}
+	condition, err := guarded(wb.client, func() (string, error) {
+		return renderCond(And(wb.conds...))
+	})
+	if err != nil {
+		return nil, err
+	}
+	stream, err := wb.client.client.Watch(wb.ctx, &proto.WatchRequest{
+		ConnectionString: wb.connectionString,
+		TableName:        wb.tableName,
+		Condition:        condition,
+		KeyColumn:        wb.keyColumn,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ChangeStream{ctx: wb.ctx, table: wb.tableName, stream: stream}, nil
+}
+
+// ChangeStream delivers the changes of a Watch subscription in the order
+// they were committed.
+type ChangeStream struct {
+	ctx    context.Context
+	table  string
+	stream proto.DatabaseService_WatchClient
+}
+
+// Recv blocks until the next change. Once the subscription's context is done
+// it returns the context's error.
+func (s *ChangeStream) Recv() (_ ChangeEvent, err error) {
+	defer wrapOpError(&err, "Watch", s.table, proto.DatabaseService_Watch_FullMethodName, time.Now())
+	event, err := s.stream.Recv()
+	if err != nil {
+		if s.ctx.Err() != nil {
+			return ChangeEvent{}, s.ctx.Err()
+		}
+		return ChangeEvent{}, err
+	}
+	ce := ChangeEvent{Type: event.Type, Key: event.Key}
+	if event.Type != ChangeInsert {
+		ce.Old = event.OldRow
+	}
+	if event.Type != ChangeDelete {
+		ce.New = event.Row
+	}
+	return ce, nil
+}